@@ -0,0 +1,339 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	"github.com/SkycoinProject/skycoin/src/util/droplet"
+
+	skyWallet "github.com/SkycoinProject/hardware-wallet-go/src/skywallet"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+)
+
+const (
+	// maxAddressN is the maximum number of addresses the firmware generates for one AddressGen message
+	maxAddressN = 99
+
+	defaultDiscoveryGapLimit  = 20
+	defaultDiscoveryBatchSize = 10
+	maxDiscoveryGapLimit      = 1000
+)
+
+// AccountDiscoveryRequest is request data for /api/v1/account_discovery
+type AccountDiscoveryRequest struct {
+	GapLimit  int `json:"gap_limit"`
+	BatchSize int `json:"batch_size"`
+}
+
+// DiscoveredAddress is an address with history found by account discovery
+type DiscoveredAddress struct {
+	Index   uint32 `json:"index"`
+	Address string `json:"address"`
+	Coins   string `json:"coins"`
+	Hours   uint64 `json:"hours"`
+}
+
+// AccountDiscoveryResponse is data returned by POST /api/v1/account_discovery
+type AccountDiscoveryResponse struct {
+	Addresses []DiscoveredAddress `json:"addresses"`
+	// Scanned is the number of addresses derived by the device
+	Scanned uint32 `json:"scanned"`
+}
+
+// accountDiscovery derives addresses in batches and checks them against a skycoin node
+// until gapLimit consecutive addresses have no history
+type accountDiscovery struct {
+	gateway   Gatewayer
+	node      *NodeClient
+	record    func(Gatewayer, uint32, []string)
+	gapLimit  uint32
+	batchSize uint32
+	// maxAddressIndex is the highest index derived, the discovery fails if it is reached
+	// before gapLimit consecutive addresses without history
+	maxAddressIndex uint32
+}
+
+// errNodeRequest wraps errors returned by the skycoin node
+type errNodeRequest struct {
+	error
+}
+
+// errDiscoveryLimit is returned when the discovery reaches the maximum address index
+type errDiscoveryLimit struct {
+	maxAddressIndex uint32
+	gapLimit        uint32
+}
+
+func (e errDiscoveryLimit) Error() string {
+	return fmt.Sprintf("addresses above index %d cannot be generated, no gap of %d unused addresses was found below it", e.maxAddressIndex, e.gapLimit)
+}
+
+// run performs the discovery. If the device does not return addresses,
+// the firmware message is returned instead of a response.
+// The firmware response to the first batch is passed in first when the discovery is resumed
+// after the device asked for user input.
+func (d accountDiscovery) run(first *wire.Message) (*AccountDiscoveryResponse, wire.Message, error) {
+	var used []DiscoveredAddress
+	var index, gap uint32
+
+	for gap < d.gapLimit {
+		if uint64(index) > uint64(d.maxAddressIndex) {
+			return nil, wire.Message{}, errDiscoveryLimit{
+				maxAddressIndex: d.maxAddressIndex,
+				gapLimit:        d.gapLimit,
+			}
+		}
+
+		n := d.batchSize
+		if uint64(index)+uint64(n)-1 > uint64(d.maxAddressIndex) {
+			n = d.maxAddressIndex - index + 1
+		}
+
+		var msg wire.Message
+		if first != nil {
+			msg = *first
+			first = nil
+		} else {
+			var err error
+			msg, err = d.gateway.AddressGen(n, index, false)
+			if err != nil {
+				return nil, wire.Message{}, err
+			}
+		}
+
+		if msg.Kind != uint16(messages.MessageType_MessageType_ResponseSkycoinAddress) {
+			return nil, msg, nil
+		}
+
+		addresses, err := skyWallet.DecodeResponseSkycoinAddress(msg)
+		if err != nil {
+			return nil, wire.Message{}, err
+		}
+
+		if len(addresses) == 0 {
+			return nil, wire.Message{}, fmt.Errorf("device returned no addresses for index %d", index)
+		}
+
+		if d.record != nil {
+			d.record(d.gateway, index, addresses)
+		}
+
+		history, err := d.node.UsedAddresses(addresses)
+		if err != nil {
+			return nil, wire.Message{}, errNodeRequest{err}
+		}
+
+		for i, addr := range addresses {
+			if !history[addr] {
+				gap++
+				if gap >= d.gapLimit {
+					break
+				}
+				continue
+			}
+
+			gap = 0
+			used = append(used, DiscoveredAddress{
+				Index:   index + uint32(i),
+				Address: addr,
+			})
+		}
+
+		index += uint32(len(addresses))
+	}
+
+	addrs := make([]string, len(used))
+	for i, u := range used {
+		addrs[i] = u.Address
+	}
+
+	balances, err := d.node.Balances(addrs)
+	if err != nil {
+		return nil, wire.Message{}, errNodeRequest{err}
+	}
+
+	for i := range used {
+		b := balances[used[i].Address]
+		used[i].Coins, err = droplet.ToString(b.Coins)
+		if err != nil {
+			return nil, wire.Message{}, errNodeRequest{err}
+		}
+		used[i].Hours = b.Hours
+	}
+
+	if used == nil {
+		used = []DiscoveredAddress{}
+	}
+
+	return &AccountDiscoveryResponse{
+		Addresses: used,
+		Scanned:   index,
+	}, wire.Message{}, nil
+}
+
+// URI: /api/v1/account_discovery
+// Method: POST
+// Args: JSON Body
+func accountDiscoveryHandler(gateway Gatewayer, node *NodeClient, addressBook *AddressBook, maxAddressIndex uint32, discoveries *accountDiscoveries) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if node == nil {
+			resp := NewHTTPErrorResponse(http.StatusForbidden, "account discovery is disabled, no skycoin node is configured")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if r.Header.Get("Content-Type") != ContentTypeJSON {
+			resp := NewHTTPErrorResponse(http.StatusUnsupportedMediaType, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		var req AccountDiscoveryRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			resp := NewHTTPErrorResponse(http.StatusBadRequest, err.Error())
+			writeHTTPResponse(w, resp)
+			return
+		}
+		defer r.Body.Close()
+
+		if req.GapLimit == 0 {
+			req.GapLimit = defaultDiscoveryGapLimit
+		}
+
+		if req.BatchSize == 0 {
+			req.BatchSize = defaultDiscoveryBatchSize
+		}
+
+		if req.GapLimit < 0 || req.GapLimit > maxDiscoveryGapLimit {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, fmt.Sprintf("gap_limit must be between 1 and %d", maxDiscoveryGapLimit))
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if req.BatchSize < 0 || req.BatchSize > maxAddressN {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, fmt.Sprintf("batch_size must be between 1 and %d", maxAddressN))
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		discovery := accountDiscovery{
+			gateway:         gateway,
+			node:            node,
+			record:          addressBook.recorder(gateway),
+			gapLimit:        uint32(req.GapLimit),
+			batchSize:       uint32(req.BatchSize),
+			maxAddressIndex: maxAddressIndex,
+		}
+
+		discoveries.respond(w, r, discovery, nil)
+	}
+}
+
+// accountDiscoveries keeps an account discovery pending while the device asks for
+// a pin code or passphrase, so that it continues after the intermediate request
+type accountDiscoveries struct {
+	lock    sync.Mutex
+	pending *accountDiscovery
+}
+
+func newAccountDiscoveries() *accountDiscoveries {
+	return &accountDiscoveries{}
+}
+
+// interrupt stops tracking the pending discovery, it was replaced on the device by a new flow
+func (a *accountDiscoveries) interrupt() {
+	a.lock.Lock()
+	a.pending = nil
+	a.lock.Unlock()
+}
+
+// failFlow stops tracking the pending discovery
+func (a *accountDiscoveries) failFlow(err error) {
+	a.interrupt()
+}
+
+// resumeFlow continues the pending discovery with the addresses of its first batch
+func (a *accountDiscoveries) resumeFlow(w http.ResponseWriter, r *http.Request, gateway Gatewayer, msg wire.Message) bool {
+	if isIntermediateMessage(msg) {
+		return false
+	}
+
+	a.lock.Lock()
+	pending := a.pending
+	a.pending = nil
+	a.lock.Unlock()
+
+	if pending == nil || msg.Kind != uint16(messages.MessageType_MessageType_ResponseSkycoinAddress) {
+		return false
+	}
+
+	a.respond(w, r, *pending, &msg)
+	return true
+}
+
+// respond runs the discovery and writes its result.
+// If the device asks for user input the discovery is kept pending.
+func (a *accountDiscoveries) respond(w http.ResponseWriter, r *http.Request, discovery accountDiscovery, first *wire.Message) {
+	var result *AccountDiscoveryResponse
+	var msg wire.Message
+	var err error
+	retCH := make(chan int, 1)
+	errCH := make(chan int, 1)
+	ctx := r.Context()
+
+	go func() {
+		result, msg, err = discovery.run(first)
+		if err != nil {
+			errCH <- 1
+			return
+		}
+		retCH <- 1
+	}()
+
+	select {
+	case <-retCH:
+		if result == nil {
+			// the device asked for a pin or passphrase, or failed
+			if isIntermediateMessage(msg) {
+				a.lock.Lock()
+				a.pending = &discovery
+				a.lock.Unlock()
+			}
+			HandleFirmwareResponseMessages(w, msg)
+			return
+		}
+
+		writeHTTPResponse(w, HTTPResponse{
+			Data: result,
+		})
+	case <-errCH:
+		logger.Errorf("accountDiscovery failed: %s", err.Error())
+		status := http.StatusInternalServerError
+		switch err.(type) {
+		case errNodeRequest:
+			status = http.StatusBadGateway
+		case errDiscoveryLimit:
+			status = http.StatusUnprocessableEntity
+		}
+		resp := NewHTTPErrorResponse(status, err.Error())
+		writeHTTPResponse(w, resp)
+	case <-ctx.Done():
+		disConnErr := discovery.gateway.Disconnect()
+		if disConnErr != nil {
+			resp := NewHTTPErrorResponse(http.StatusInternalServerError, disConnErr.Error())
+			writeHTTPResponse(w, resp)
+		} else {
+			resp := NewHTTPErrorResponse(499, "Client Closed Request")
+			writeHTTPResponse(w, resp)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+)
+
+// newTestNode starts a skycoin node stand-in which knows the history and balance of the given addresses
+func newTestNode(t *testing.T, balances map[string]NodeBalance) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		addrs := strings.Split(r.URL.Query().Get("addrs"), ",")
+
+		switch r.URL.Path {
+		case "/api/v1/transactions":
+			var txns []nodeTransaction
+			for _, addr := range addrs {
+				if _, ok := balances[addr]; !ok {
+					continue
+				}
+				var txn nodeTransaction
+				txn.Txn.Outputs = append(txn.Txn.Outputs, struct {
+					Address string `json:"dst"`
+				}{addr})
+				txns = append(txns, txn)
+			}
+			require.NoError(t, json.NewEncoder(w).Encode(txns))
+		case "/api/v1/balance":
+			resp := map[string]interface{}{}
+			for _, addr := range addrs {
+				resp[addr] = map[string]interface{}{
+					"confirmed": balances[addr],
+				}
+			}
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+				"addresses": resp,
+			}))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestAccountDiscovery(t *testing.T) {
+	node := newTestNode(t, map[string]NodeBalance{
+		"a1": {Coins: 1000000, Hours: 10},
+		"a4": {Coins: 2500000, Hours: 0},
+	})
+	defer node.Close()
+
+	brokenNode := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer brokenNode.Close()
+
+	newGateway := func() *MockGatewayer {
+		gateway := &MockGatewayer{}
+		for i := uint32(0); i < 20; i += 2 {
+			gateway.On("AddressGen", uint32(2), i, false).Return(testAddressesMessage(t, fmt.Sprintf("a%d", i), fmt.Sprintf("a%d", i+1)), nil)
+		}
+		gateway.On("AddressGen", uint32(1), uint32(6), false).Return(testAddressesMessage(t, "a6"), nil)
+		return gateway
+	}
+
+	cases := []struct {
+		name            string
+		method          string
+		status          int
+		httpBody        string
+		node            string
+		maxAddressIndex uint32
+		httpResponse    HTTPResponse
+		result          *AccountDiscoveryResponse
+	}{
+		{
+			name:         "405",
+			method:       http.MethodGet,
+			status:       http.StatusMethodNotAllowed,
+			node:         node.URL,
+			httpResponse: NewHTTPErrorResponse(http.StatusMethodNotAllowed, ""),
+		},
+
+		{
+			name:         "403 - disabled",
+			method:       http.MethodPost,
+			status:       http.StatusForbidden,
+			httpBody:     `{}`,
+			httpResponse: NewHTTPErrorResponse(http.StatusForbidden, "account discovery is disabled, no skycoin node is configured"),
+		},
+
+		{
+			name:         "422 - batch_size too big",
+			method:       http.MethodPost,
+			status:       http.StatusUnprocessableEntity,
+			httpBody:     `{"batch_size": 100}`,
+			node:         node.URL,
+			httpResponse: NewHTTPErrorResponse(http.StatusUnprocessableEntity, "batch_size must be between 1 and 99"),
+		},
+
+		{
+			name:         "422 - negative gap_limit",
+			method:       http.MethodPost,
+			status:       http.StatusUnprocessableEntity,
+			httpBody:     `{"gap_limit": -1}`,
+			node:         node.URL,
+			httpResponse: NewHTTPErrorResponse(http.StatusUnprocessableEntity, "gap_limit must be between 1 and 1000"),
+		},
+
+		{
+			name:         "502 - node error",
+			method:       http.MethodPost,
+			status:       http.StatusBadGateway,
+			httpBody:     `{"gap_limit": 3, "batch_size": 2}`,
+			node:         brokenNode.URL,
+			httpResponse: NewHTTPErrorResponse(http.StatusBadGateway, "node returned 503 Service Unavailable: unavailable"),
+		},
+
+		{
+			name:            "422 - max address index",
+			method:          http.MethodPost,
+			status:          http.StatusUnprocessableEntity,
+			httpBody:        `{"gap_limit": 3, "batch_size": 2}`,
+			node:            node.URL,
+			maxAddressIndex: 6,
+			httpResponse:    NewHTTPErrorResponse(http.StatusUnprocessableEntity, "addresses above index 6 cannot be generated, no gap of 3 unused addresses was found below it"),
+		},
+
+		{
+			name:     "200 - OK",
+			method:   http.MethodPost,
+			status:   http.StatusOK,
+			httpBody: `{"gap_limit": 3, "batch_size": 2}`,
+			node:     node.URL,
+			result: &AccountDiscoveryResponse{
+				Addresses: []DiscoveredAddress{
+					{Index: 1, Address: "a1", Coins: "1.000000", Hours: 10},
+					{Index: 4, Address: "a4", Coins: "2.500000", Hours: 0},
+				},
+				Scanned: 8,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mc := defaultMuxConfig()
+			if tc.node != "" {
+				mc.node = NewNodeClient(tc.node)
+			}
+			if tc.maxAddressIndex != 0 {
+				mc.maxAddressIndex = tc.maxAddressIndex
+			}
+			handler := newServerMux(mc, newGateway())
+
+			rr := serveTestRequest(t, handler, tc.method, "/account_discovery", tc.httpBody, map[string]string{
+				"Content-Type": ContentTypeJSON,
+			})
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+
+			var rsp ReceivedHTTPResponse
+			err := json.NewDecoder(rr.Body).Decode(&rsp)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.httpResponse.Error, rsp.Error)
+
+			if tc.result != nil {
+				var result AccountDiscoveryResponse
+				err = json.Unmarshal(rsp.Data, &result)
+				require.NoError(t, err)
+				require.Equal(t, *tc.result, result)
+			}
+		})
+	}
+}
+
+func TestAccountDiscoveryPinMatrix(t *testing.T) {
+	node := newTestNode(t, map[string]NodeBalance{
+		"a1": {Coins: 1000000, Hours: 10},
+	})
+	defer node.Close()
+
+	gateway := &MockGatewayer{}
+	gateway.On("AddressGen", uint32(2), uint32(0), false).Return(testPinMatrixRequestMessage(t, messages.PinMatrixRequestType_PinMatrixRequestType_Current), nil).Once()
+	gateway.On("PinMatrixAck", "1234").Return(testAddressesMessage(t, "a0", "a1"), nil)
+	gateway.On("AddressGen", uint32(2), uint32(2), false).Return(testAddressesMessage(t, "a2", "a3"), nil)
+	gateway.On("AddressGen", uint32(2), uint32(4), false).Return(testAddressesMessage(t, "a4", "a5"), nil)
+
+	mc := defaultMuxConfig()
+	mc.node = NewNodeClient(node.URL)
+	handler := newServerMux(mc, gateway)
+
+	rr := serveTestRequest(t, handler, http.MethodPost, "/account_discovery", `{"gap_limit": 3, "batch_size": 2}`, map[string]string{
+		"Content-Type": ContentTypeJSON,
+	})
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	require.JSONEq(t, `{"data":["PinMatrixRequest"],"intermediate":{"kind":"pin_matrix","operation":"account_discovery","pin_matrix_request_type":"current"}}`, rr.Body.String())
+
+	// the discovery continues with the addresses returned after the pin code is entered
+	rr = serveTestRequest(t, handler, http.MethodPost, "/intermediate/pin_matrix", `{"pin": "1234"}`, map[string]string{
+		"Content-Type": ContentTypeJSON,
+	})
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	var rsp ReceivedHTTPResponse
+	err := json.NewDecoder(rr.Body).Decode(&rsp)
+	require.NoError(t, err)
+
+	var result AccountDiscoveryResponse
+	err = json.Unmarshal(rsp.Data, &result)
+	require.NoError(t, err)
+	require.Equal(t, AccountDiscoveryResponse{
+		Addresses: []DiscoveredAddress{
+			{Index: 1, Address: "a1", Coins: "1.000000", Hours: 10},
+		},
+		Scanned: 6,
+	}, result)
+
+	gateway.AssertExpectations(t)
+}
+
+func TestAccountDiscoveryInterrupted(t *testing.T) {
+	node := newTestNode(t, nil)
+	defer node.Close()
+
+	gateway := &MockGatewayer{}
+	gateway.On("AddressGen", uint32(2), uint32(0), false).Return(testPinMatrixRequestMessage(t, messages.PinMatrixRequestType_PinMatrixRequestType_Current), nil)
+	gateway.On("Wipe").Return(buttonRequestMessage, nil)
+	gateway.On("PinMatrixAck", "1234").Return(testAddressesMessage(t, "a0", "a1"), nil)
+
+	mc := defaultMuxConfig()
+	mc.node = NewNodeClient(node.URL)
+	handler := newServerMux(mc, gateway)
+
+	rr := serveTestRequest(t, handler, http.MethodPost, "/account_discovery", `{"gap_limit": 3, "batch_size": 2}`, map[string]string{
+		"Content-Type": ContentTypeJSON,
+	})
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	// a new flow replaces the pending discovery on the device
+	rr = serveTestRequest(t, handler, http.MethodDelete, "/wipe", "", nil)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	rr = serveTestRequest(t, handler, http.MethodPost, "/intermediate/pin_matrix", `{"pin": "1234"}`, map[string]string{
+		"Content-Type": ContentTypeJSON,
+	})
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	require.JSONEq(t, `{"data":["a0","a1"]}`, rr.Body.String())
+	gateway.AssertNotCalled(t, "AddressGen", uint32(2), uint32(2), false)
+}
@@ -1,12 +1,17 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
+	"sync"
 
 	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
 
 	skyWallet "github.com/SkycoinProject/hardware-wallet-go/src/skywallet"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
 )
 
 // GenerateAddressesRequest is request data for /api/v1/generate_addresses
@@ -16,11 +21,19 @@ type GenerateAddressesRequest struct {
 	ConfirmAddress bool `json:"confirm_address"`
 }
 
+// AddressBatch is a line of the NDJSON stream returned by /api/v1/generate_addresses
+type AddressBatch struct {
+	StartIndex uint32   `json:"start_index"`
+	Addresses  []string `json:"addresses"`
+}
+
 // generateAddresses generates addresses for hardware wallet.
+// More than maxAddressN addresses are generated in batches. If the Accept header
+// is application/x-ndjson, each batch is streamed as a line of JSON.
 // URI: /api/v1/generate_addresses
 // Method: POST
 // Args: JSON Body
-func generateAddresses(gateway Gatewayer) http.HandlerFunc {
+func generateAddresses(gateway Gatewayer, addressBook *AddressBook, maxAddressIndex uint32, generations *addressGenerations) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
@@ -60,9 +73,19 @@ func generateAddresses(gateway Gatewayer) http.HandlerFunc {
 			return
 		}
 
-		// simple warning for logs
-		if req.AddressN+req.StartIndex > 8 {
-			logger.Warnf("wallet generating high index addresses: start_index: %d; address_n: %d", req.StartIndex, req.AddressN)
+		if req.StartIndex+req.AddressN-1 > int(maxAddressIndex) {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, fmt.Sprintf("addresses above index %d cannot be generated", maxAddressIndex))
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		stream := strings.Contains(r.Header.Get("Accept"), ContentTypeNDJSON)
+		batched := stream || req.AddressN > maxAddressN
+
+		if batched && req.ConfirmAddress {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, fmt.Sprintf("confirm_address cannot be used with more than %d addresses or a streamed response", maxAddressN))
+			writeHTTPResponse(w, resp)
+			return
 		}
 
 		// for integration tests
@@ -76,6 +99,17 @@ func generateAddresses(gateway Gatewayer) http.HandlerFunc {
 			}
 		}
 
+		if batched {
+			generations.respond(w, r, gateway, pendingAddressBatches{
+				addressN:   uint32(req.AddressN),
+				startIndex: uint32(req.StartIndex),
+				stream:     stream,
+			}, nil)
+			return
+		}
+
+		addressBookDone := addressBook.addressGen(gateway, uint32(req.StartIndex))
+
 		var msg wire.Message
 		var err error
 		retCH := make(chan int)
@@ -93,6 +127,7 @@ func generateAddresses(gateway Gatewayer) http.HandlerFunc {
 
 		select {
 		case <-retCH:
+			addressBook.finish(gateway, addressBookDone, msg)
 			HandleFirmwareResponseMessages(w, msg)
 		case <-errCH:
 			logger.Error("generateAddresses failed: %s", err.Error())
@@ -110,3 +145,196 @@ func generateAddresses(gateway Gatewayer) http.HandlerFunc {
 		}
 	}
 }
+
+// addressBatches derives addressN addresses in batches of at most maxAddressN and sends each batch on the channel.
+// If the device does not return addresses, the firmware message is returned.
+// The firmware response to the first batch is passed in derived when the generation
+// is resumed after the device asked for user input.
+func addressBatches(ctx context.Context, gateway Gatewayer, addressN, startIndex uint32, record func(Gatewayer, uint32, []string), batches chan<- AddressBatch, derived *wire.Message) (*wire.Message, error) {
+	for generated := uint32(0); generated < addressN; {
+		n := addressN - generated
+		if n > maxAddressN {
+			n = maxAddressN
+		}
+		index := startIndex + generated
+
+		var msg wire.Message
+		var err error
+		if generated == 0 && derived != nil {
+			msg = *derived
+		} else {
+			msg, err = gateway.AddressGen(n, index, false)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if msg.Kind != uint16(messages.MessageType_MessageType_ResponseSkycoinAddress) {
+			// answering a prompt after the first batch would only return the addresses of one batch
+			if generated == 0 || msg.Kind == uint16(messages.MessageType_MessageType_Failure) {
+				return &msg, nil
+			}
+			return nil, fmt.Errorf("received unexpected response message type: %s", messages.MessageType(msg.Kind))
+		}
+
+		addresses, err := skyWallet.DecodeResponseSkycoinAddress(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(addresses) != int(n) {
+			return nil, fmt.Errorf("device returned %d addresses for index %d, expected %d", len(addresses), index, n)
+		}
+
+		if record != nil {
+			record(gateway, index, addresses)
+		}
+
+		select {
+		case batches <- AddressBatch{
+			StartIndex: index,
+			Addresses:  addresses,
+		}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		generated += n
+	}
+
+	return nil, nil
+}
+
+// pendingAddressBatches is a batched address generation waiting for a pin code or passphrase
+type pendingAddressBatches struct {
+	addressN   uint32
+	startIndex uint32
+	stream     bool
+}
+
+// addressGenerations keeps a batched address generation pending while the device asks for
+// a pin code or passphrase, so that the remaining batches are generated and recorded after
+// the intermediate request
+type addressGenerations struct {
+	addressBook *AddressBook
+	lock        sync.Mutex
+	pending     *pendingAddressBatches
+}
+
+func newAddressGenerations(addressBook *AddressBook) *addressGenerations {
+	return &addressGenerations{
+		addressBook: addressBook,
+	}
+}
+
+// interrupt stops tracking the pending generation, it was replaced on the device by a new flow
+func (g *addressGenerations) interrupt() {
+	g.lock.Lock()
+	g.pending = nil
+	g.lock.Unlock()
+}
+
+// failFlow stops tracking the pending generation
+func (g *addressGenerations) failFlow(err error) {
+	g.interrupt()
+}
+
+// resumeFlow generates the remaining batches of the pending generation, the first batch
+// is the firmware response of the intermediate request
+func (g *addressGenerations) resumeFlow(w http.ResponseWriter, r *http.Request, gateway Gatewayer, msg wire.Message) bool {
+	if isIntermediateMessage(msg) {
+		return false
+	}
+
+	g.lock.Lock()
+	pending := g.pending
+	g.pending = nil
+	g.lock.Unlock()
+
+	if pending == nil || msg.Kind != uint16(messages.MessageType_MessageType_ResponseSkycoinAddress) {
+		return false
+	}
+
+	g.respond(w, r, gateway, *pending, &msg)
+	return true
+}
+
+// respond writes the addresses generated by addressBatches,
+// either as a single response or as a NDJSON stream of AddressBatch lines.
+// Errors after the stream started are written as a line with an error field.
+// If the device asks for a pin code or passphrase the generation is kept pending.
+func (g *addressGenerations) respond(w http.ResponseWriter, r *http.Request, gateway Gatewayer, generation pendingAddressBatches, derived *wire.Message) {
+	addressN := generation.addressN
+	stream := generation.stream
+	record := g.addressBook.recorder(gateway)
+
+	var msg *wire.Message
+	var err error
+	batches := make(chan AddressBatch)
+	doneCH := make(chan struct{})
+	ctx := r.Context()
+
+	go func() {
+		defer close(doneCH)
+		msg, err = addressBatches(ctx, gateway, addressN, generation.startIndex, record, batches, derived)
+	}()
+
+	addresses := make([]string, 0, addressN)
+	started := false
+
+	writeError := func(resp HTTPResponse) {
+		if started {
+			writeNDJSON(w, resp)
+			return
+		}
+		writeHTTPResponse(w, resp)
+	}
+
+	for {
+		select {
+		case batch := <-batches:
+			if !stream {
+				addresses = append(addresses, batch.Addresses...)
+				continue
+			}
+
+			if !started {
+				w.Header().Set("Content-Type", ContentTypeNDJSON)
+				w.WriteHeader(http.StatusOK)
+				started = true
+			}
+
+			writeNDJSON(w, batch)
+			flush(w, r)
+		case <-doneCH:
+			switch {
+			case err != nil:
+				logger.Errorf("generateAddresses failed: %s", err.Error())
+				writeError(NewHTTPErrorResponse(http.StatusInternalServerError, err.Error()))
+			case msg != nil && !started:
+				// the device asked for a pin code or passphrase, or failed
+				if isIntermediateMessage(*msg) {
+					g.lock.Lock()
+					g.pending = &generation
+					g.lock.Unlock()
+				}
+				HandleFirmwareResponseMessages(w, *msg)
+			case msg != nil:
+				writeError(newFailureResponse(*msg))
+			case !stream:
+				writeHTTPResponse(w, HTTPResponse{
+					Data: addresses,
+				})
+			}
+			return
+		case <-ctx.Done():
+			disConnErr := gateway.Disconnect()
+			if disConnErr != nil {
+				writeError(NewHTTPErrorResponse(http.StatusInternalServerError, disConnErr.Error()))
+			} else {
+				writeError(NewHTTPErrorResponse(499, "Client Closed Request"))
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,426 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	"github.com/SkycoinProject/skycoin/src/util/file"
+
+	skyWallet "github.com/SkycoinProject/hardware-wallet-go/src/skywallet"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+)
+
+// AddressBookDirname is the name of the address book directory in the data directory
+const AddressBookDirname = "addresses"
+
+var (
+	// ErrUnknownPassphraseSession is returned when the addresses of a passphrase protected device
+	// are requested before any address was generated with the current passphrase
+	ErrUnknownPassphraseSession = errors.New("unknown passphrase session, generate addresses first")
+
+	deviceIDRegex = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+)
+
+// AddressBookEntry is an address derived by the device
+type AddressBookEntry struct {
+	Index   uint32 `json:"index"`
+	Address string `json:"address"`
+}
+
+// AddressBookResponse is data returned by GET /api/v1/addresses
+type AddressBookResponse struct {
+	DeviceID  string             `json:"device_id"`
+	Addresses []AddressBookEntry `json:"addresses"`
+}
+
+// addressBookFile is the stored address book of a device.
+// Addresses are grouped by passphrase session and keyed by index.
+type addressBookFile struct {
+	Sessions map[string]map[uint32]string `json:"sessions"`
+}
+
+// AddressBook stores the addresses derived by each device so they can be listed
+// without asking the device again. Each device has its own file, in which addresses
+// are grouped by passphrase session. Devices without passphrase protection only have
+// the empty session. For passphrase protected devices the session is identified by
+// the address at index 0, which is different for every passphrase.
+type AddressBook struct {
+	dir  string
+	lock sync.Mutex
+	// sessions maps device ids to their current passphrase session
+	sessions map[string]string
+	// pending completes an operation which is waiting for user input
+	pending func(Gatewayer, wire.Message)
+}
+
+// NewAddressBook creates an AddressBook storing files in dir
+func NewAddressBook(dir string) *AddressBook {
+	return &AddressBook{
+		dir:      dir,
+		sessions: make(map[string]string),
+	}
+}
+
+// recorder returns a function storing addresses generated by the connected device
+func (b *AddressBook) recorder(gateway Gatewayer) func(gateway Gatewayer, startIndex uint32, addresses []string) {
+	if b == nil {
+		return nil
+	}
+
+	features, err := deviceFeatures(gateway)
+	if err != nil {
+		logger.WithError(err).Warning("address book: failed to get device features")
+		return nil
+	}
+
+	deviceID := features.GetDeviceId()
+	if deviceID == "" {
+		return nil
+	}
+	usePassphrase := features.GetPassphraseProtection()
+
+	return func(gateway Gatewayer, startIndex uint32, addresses []string) {
+		if len(addresses) == 0 {
+			return
+		}
+
+		session, err := b.session(gateway, deviceID, usePassphrase, startIndex, addresses)
+		if err != nil {
+			logger.WithError(err).Warning("address book: failed to get passphrase session")
+			return
+		}
+
+		if err := b.store(deviceID, session, startIndex, addresses); err != nil {
+			logger.WithError(err).Error("address book: failed to store addresses")
+		}
+	}
+}
+
+// addressGen prepares to store the addresses returned by AddressGen
+func (b *AddressBook) addressGen(gateway Gatewayer, startIndex uint32) func(Gatewayer, wire.Message) {
+	record := b.recorder(gateway)
+	if record == nil {
+		return nil
+	}
+
+	return func(gateway Gatewayer, msg wire.Message) {
+		if msg.Kind != uint16(messages.MessageType_MessageType_ResponseSkycoinAddress) {
+			return
+		}
+
+		addresses, err := skyWallet.DecodeResponseSkycoinAddress(msg)
+		if err != nil {
+			return
+		}
+
+		record(gateway, startIndex, addresses)
+	}
+}
+
+// reset prepares to clear the addresses of the device once it is wiped or seeded again
+func (b *AddressBook) reset(gateway Gatewayer) func(Gatewayer, wire.Message) {
+	if b == nil {
+		return nil
+	}
+
+	features, err := deviceFeatures(gateway)
+	if err != nil {
+		logger.WithError(err).Warning("address book: failed to get device features")
+		return nil
+	}
+
+	deviceID := features.GetDeviceId()
+	if deviceID == "" {
+		return nil
+	}
+
+	return func(_ Gatewayer, msg wire.Message) {
+		if msg.Kind != uint16(messages.MessageType_MessageType_Success) {
+			return
+		}
+
+		if err := b.clear(deviceID); err != nil {
+			logger.WithError(err).Error("address book: failed to clear addresses")
+		}
+	}
+}
+
+// finish completes an operation prepared with addressGen or reset.
+// If the firmware asks for user input the operation is kept pending.
+func (b *AddressBook) finish(gateway Gatewayer, done func(Gatewayer, wire.Message), msg wire.Message) {
+	if b == nil || done == nil {
+		return
+	}
+
+	if isIntermediateMessage(msg) {
+		b.lock.Lock()
+		b.pending = done
+		b.lock.Unlock()
+		return
+	}
+
+	done(gateway, msg)
+}
+
+// resume completes the pending operation with the firmware response of an intermediate request
+func (b *AddressBook) resume(gateway Gatewayer, msg wire.Message) {
+	if b == nil || isIntermediateMessage(msg) {
+		return
+	}
+
+	b.lock.Lock()
+	done := b.pending
+	b.pending = nil
+	b.lock.Unlock()
+
+	if done != nil {
+		done(gateway, msg)
+	}
+}
+
+// interrupt drops the pending operation
+func (b *AddressBook) interrupt() {
+	if b == nil {
+		return
+	}
+
+	b.lock.Lock()
+	b.pending = nil
+	b.lock.Unlock()
+}
+
+// resumeFlow completes the pending operation, it does not write the response
+func (b *AddressBook) resumeFlow(w http.ResponseWriter, r *http.Request, gateway Gatewayer, msg wire.Message) bool {
+	b.resume(gateway, msg)
+	return false
+}
+
+// failFlow drops the pending operation
+func (b *AddressBook) failFlow(err error) {
+	b.interrupt()
+}
+
+// forgetSessions is called when a new passphrase is entered, which may start a new passphrase session
+func (b *AddressBook) forgetSessions() {
+	if b == nil {
+		return
+	}
+
+	b.lock.Lock()
+	b.sessions = make(map[string]string)
+	b.lock.Unlock()
+}
+
+// session returns the passphrase session which the addresses belong to
+func (b *AddressBook) session(gateway Gatewayer, deviceID string, usePassphrase bool, startIndex uint32, addresses []string) (string, error) {
+	if !usePassphrase {
+		return "", nil
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if startIndex == 0 {
+		b.sessions[deviceID] = addresses[0]
+		return addresses[0], nil
+	}
+
+	if session, ok := b.sessions[deviceID]; ok {
+		return session, nil
+	}
+
+	// the passphrase is cached on the device at this point so this does not need user input
+	msg, err := gateway.AddressGen(1, 0, false)
+	if err != nil {
+		return "", err
+	}
+
+	if msg.Kind != uint16(messages.MessageType_MessageType_ResponseSkycoinAddress) {
+		return "", fmt.Errorf("received unexpected response message type: %s", messages.MessageType(msg.Kind))
+	}
+
+	first, err := skyWallet.DecodeResponseSkycoinAddress(msg)
+	if err != nil {
+		return "", err
+	}
+
+	if len(first) != 1 {
+		return "", errors.New("device returned no address")
+	}
+
+	b.sessions[deviceID] = first[0]
+	return first[0], nil
+}
+
+// currentSession returns the passphrase session of the device known to the address book
+func (b *AddressBook) currentSession(deviceID string, usePassphrase bool) (string, error) {
+	if !usePassphrase {
+		return "", nil
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	session, ok := b.sessions[deviceID]
+	if !ok {
+		return "", ErrUnknownPassphraseSession
+	}
+
+	return session, nil
+}
+
+func (b *AddressBook) path(deviceID string) (string, error) {
+	if !deviceIDRegex.MatchString(deviceID) {
+		return "", fmt.Errorf("invalid device id %q", deviceID)
+	}
+
+	return filepath.Join(b.dir, deviceID+".json"), nil
+}
+
+func (b *AddressBook) load(deviceID string) (*addressBookFile, error) {
+	path, err := b.path(deviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	book := &addressBookFile{}
+	if err := file.LoadJSON(path, book); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	if book.Sessions == nil {
+		book.Sessions = make(map[string]map[uint32]string)
+	}
+
+	return book, nil
+}
+
+func (b *AddressBook) store(deviceID, session string, startIndex uint32, addresses []string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	book, err := b.load(deviceID)
+	if err != nil {
+		return err
+	}
+
+	entries := book.Sessions[session]
+	if entries == nil {
+		entries = make(map[uint32]string, len(addresses))
+		book.Sessions[session] = entries
+	}
+
+	for i, addr := range addresses {
+		entries[startIndex+uint32(i)] = addr
+	}
+
+	if err := os.MkdirAll(b.dir, 0750); err != nil {
+		return err
+	}
+
+	path, err := b.path(deviceID)
+	if err != nil {
+		return err
+	}
+
+	return file.SaveJSON(path, book, 0600)
+}
+
+func (b *AddressBook) clear(deviceID string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	delete(b.sessions, deviceID)
+
+	path, err := b.path(deviceID)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// Addresses returns the known addresses of a device passphrase session, ordered by index
+func (b *AddressBook) Addresses(deviceID, session string) ([]AddressBookEntry, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	book, err := b.load(deviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]AddressBookEntry, 0, len(book.Sessions[session]))
+	for index, addr := range book.Sessions[session] {
+		entries = append(entries, AddressBookEntry{
+			Index:   index,
+			Address: addr,
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Index < entries[j].Index
+	})
+
+	return entries, nil
+}
+
+// URI: /api/v1/addresses
+// Method: GET
+func addressBookHandler(gateway Gatewayer, addressBook *AddressBook) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if addressBook == nil {
+			resp := NewHTTPErrorResponse(http.StatusForbidden, "address book is disabled")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		features, err := deviceFeatures(gateway)
+		if err != nil {
+			logger.Errorf("addressBook failed: %s", err.Error())
+			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		deviceID := features.GetDeviceId()
+		session, err := addressBook.currentSession(deviceID, features.GetPassphraseProtection())
+		if err != nil {
+			resp := NewHTTPErrorResponse(http.StatusConflict, err.Error())
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		addresses, err := addressBook.Addresses(deviceID, session)
+		if err != nil {
+			logger.Errorf("addressBook failed: %s", err.Error())
+			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		writeHTTPResponse(w, HTTPResponse{
+			Data: AddressBookResponse{
+				DeviceID:  deviceID,
+				Addresses: addresses,
+			},
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package api
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAddressBook(t *testing.T) (*AddressBook, func()) {
+	dir, err := ioutil.TempDir("", "addressbook")
+	require.NoError(t, err)
+
+	return NewAddressBook(filepath.Join(dir, AddressBookDirname)), func() {
+		os.RemoveAll(dir)
+	}
+}
+
+func testAddressesMessage(t *testing.T, addresses ...string) wire.Message {
+	response := messages.ResponseSkycoinAddress{
+		Addresses: addresses,
+	}
+
+	data, err := response.Marshal()
+	require.NoError(t, err)
+
+	return wire.Message{
+		Kind: uint16(messages.MessageType_MessageType_ResponseSkycoinAddress),
+		Data: data,
+	}
+}
+
+func getAddressBook(t *testing.T, handler http.Handler) (int, *AddressBookResponse) {
+	rr := serveTestRequest(t, handler, http.MethodGet, "/addresses", "", nil)
+
+	var rsp ReceivedHTTPResponse
+	err := json.NewDecoder(rr.Body).Decode(&rsp)
+	require.NoError(t, err)
+
+	if rsp.Error != nil {
+		return rr.Code, nil
+	}
+
+	var book AddressBookResponse
+	err = json.Unmarshal(rsp.Data, &book)
+	require.NoError(t, err)
+
+	return rr.Code, &book
+}
+
+func TestAddressBook(t *testing.T) {
+	addressBook, cleanup := newTestAddressBook(t)
+	defer cleanup()
+
+	gateway := &MockGatewayer{}
+	gateway.On("GetFeatures").Return(testFeaturesMessage(t, "DEVICE1", false), nil)
+	gateway.On("AddressGen", uint32(2), uint32(3), false).Return(testAddressesMessage(t, "addr3", "addr4"), nil)
+	gateway.On("AddressGen", uint32(2), uint32(0), false).Return(testAddressesMessage(t, "addr0", "addr1"), nil)
+	gateway.On("Wipe").Return(buttonRequestMessage, nil)
+	gateway.On("ButtonAck").Return(testSuccessMessage(t, "Device wiped"), nil)
+
+	mc := defaultMuxConfig()
+	mc.addressBook = addressBook
+	handler := newServerMux(mc, gateway)
+
+	headers := map[string]string{
+		"Content-Type": ContentTypeJSON,
+	}
+
+	status, book := getAddressBook(t, handler)
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "DEVICE1", book.DeviceID)
+	require.Empty(t, book.Addresses)
+
+	rr := serveTestRequest(t, handler, http.MethodPost, "/generate_addresses", `{"address_n": 2, "start_index": 3}`, headers)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	rr = serveTestRequest(t, handler, http.MethodPost, "/generate_addresses", `{"address_n": 2, "start_index": 0}`, headers)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	status, book = getAddressBook(t, handler)
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, []AddressBookEntry{
+		{Index: 0, Address: "addr0"},
+		{Index: 1, Address: "addr1"},
+		{Index: 3, Address: "addr3"},
+		{Index: 4, Address: "addr4"},
+	}, book.Addresses)
+
+	// the address book is stored on disk
+	status, book = getAddressBook(t, newServerMux(muxConfig{
+		host:        configuredHost,
+		addressBook: NewAddressBook(addressBook.dir),
+	}, gateway))
+	require.Equal(t, http.StatusOK, status)
+	require.Len(t, book.Addresses, 4)
+
+	// wiping the device clears the address book once confirmed
+	rr = serveTestRequest(t, handler, http.MethodDelete, "/wipe", "", nil)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	_, book = getAddressBook(t, handler)
+	require.Len(t, book.Addresses, 4)
+
+	rr = serveTestRequest(t, handler, http.MethodPost, "/intermediate/button", "", nil)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	_, book = getAddressBook(t, handler)
+	require.Empty(t, book.Addresses)
+}
+
+func TestAddressBookPassphraseSession(t *testing.T) {
+	addressBook, cleanup := newTestAddressBook(t)
+	defer cleanup()
+
+	passphraseRequestMessage := wire.Message{
+		Kind: uint16(messages.MessageType_MessageType_PassphraseRequest),
+	}
+
+	gateway := &MockGatewayer{}
+	gateway.On("GetFeatures").Return(testFeaturesMessage(t, "DEVICE1", true), nil)
+	gateway.On("AddressGen", uint32(1), uint32(5), false).Return(passphraseRequestMessage, nil)
+	gateway.On("PassphraseAck", "foo").Return(testAddressesMessage(t, "foo5"), nil).Once()
+	gateway.On("PassphraseAck", "bar").Return(testAddressesMessage(t, "bar5"), nil).Once()
+	gateway.On("AddressGen", uint32(1), uint32(0), false).Return(testAddressesMessage(t, "foo0"), nil).Once()
+	gateway.On("AddressGen", uint32(1), uint32(0), false).Return(testAddressesMessage(t, "bar0"), nil).Once()
+
+	mc := defaultMuxConfig()
+	mc.addressBook = addressBook
+	handler := newServerMux(mc, gateway)
+
+	headers := map[string]string{
+		"Content-Type": ContentTypeJSON,
+	}
+
+	// the passphrase session is not known yet
+	status, _ := getAddressBook(t, handler)
+	require.Equal(t, http.StatusConflict, status)
+
+	for _, passphrase := range []string{"foo", "bar"} {
+		rr := serveTestRequest(t, handler, http.MethodPost, "/generate_addresses", `{"address_n": 1, "start_index": 5}`, headers)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		rr = serveTestRequest(t, handler, http.MethodPost, "/intermediate/passphrase", toJSON(t, PassPhraseRequest{Passphrase: passphrase}), headers)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		status, book := getAddressBook(t, handler)
+		require.Equal(t, http.StatusOK, status)
+		require.Equal(t, []AddressBookEntry{
+			{Index: 5, Address: passphrase + "5"},
+		}, book.Addresses)
+	}
+}
@@ -0,0 +1,383 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	"github.com/SkycoinProject/skycoin/src/cipher"
+
+	skyWallet "github.com/SkycoinProject/hardware-wallet-go/src/skywallet"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+)
+
+// DefaultAddressLookupLimit is the default number of addresses scanned by an address index lookup
+const DefaultAddressLookupLimit = 500
+
+// AddressIndexRequest is request data for /api/v1/address_index
+type AddressIndexRequest struct {
+	Address string `json:"address"`
+	// Limit is the number of addresses to scan, it can't be greater than the daemon lookup limit
+	Limit int `json:"limit"`
+}
+
+// AddressIndexResponse is data returned by POST /api/v1/address_index
+type AddressIndexResponse struct {
+	Address string  `json:"address"`
+	Found   bool    `json:"found"`
+	Index   *uint32 `json:"index,omitempty"`
+	// Limit is the number of addresses searched from index 0
+	Limit uint32 `json:"limit"`
+}
+
+// addressLookup finds the index of an address of the connected device.
+// Addresses in the address book are checked first, then the device addresses
+// are scanned in batches from index 0.
+// If the device asks for a pin code or passphrase during the scan, the request
+// which resolves the address is kept pending and served again once the device
+// answered the scan after the intermediate requests.
+type addressLookup struct {
+	gateway     Gatewayer
+	addressBook *AddressBook
+	// limit is the number of addresses scanned
+	limit   uint32
+	lock    sync.Mutex
+	pending *lookupRequest
+}
+
+// lookupRequest is a request which resolves an address, kept to serve it again
+type lookupRequest struct {
+	handler http.Handler
+	method  string
+	url     string
+	header  http.Header
+	body    []byte
+}
+
+type lookupRequestKey struct{}
+
+func newAddressLookup(gateway Gatewayer, addressBook *AddressBook, limit uint32) *addressLookup {
+	return &addressLookup{
+		gateway:     gateway,
+		addressBook: addressBook,
+		limit:       limit,
+	}
+}
+
+// find returns the index of address among the first limit addresses of the device.
+// If the device does not return addresses, the firmware message is returned.
+func (l *addressLookup) find(ctx context.Context, address string, limit uint32) (*uint32, *wire.Message, error) {
+	if index, ok := l.cached(address, limit); ok {
+		return &index, nil, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var msg *wire.Message
+	var err error
+	batches := make(chan AddressBatch)
+	doneCH := make(chan struct{})
+
+	go func() {
+		defer close(doneCH)
+		msg, err = addressBatches(ctx, l.gateway, limit, 0, l.addressBook.recorder(l.gateway), batches, nil)
+	}()
+
+	for {
+		select {
+		case batch := <-batches:
+			for i, addr := range batch.Addresses {
+				if addr == address {
+					// stop the scan, the error it returns is not relevant
+					cancel()
+					<-doneCH
+
+					index := batch.StartIndex + uint32(i)
+					return &index, nil, nil
+				}
+			}
+		case <-doneCH:
+			return nil, msg, err
+		}
+	}
+}
+
+// cached looks for the address in the address book. A cached index is only
+// returned if the device still derives the same address for it.
+func (l *addressLookup) cached(address string, limit uint32) (uint32, bool) {
+	if l.addressBook == nil {
+		return 0, false
+	}
+
+	features, err := deviceFeatures(l.gateway)
+	if err != nil {
+		return 0, false
+	}
+
+	session, err := l.addressBook.currentSession(features.GetDeviceId(), features.GetPassphraseProtection())
+	if err != nil {
+		return 0, false
+	}
+
+	entries, err := l.addressBook.Addresses(features.GetDeviceId(), session)
+	if err != nil {
+		logger.WithError(err).Warning("address lookup: failed to load address book")
+		return 0, false
+	}
+
+	for _, e := range entries {
+		if e.Address != address || e.Index >= limit {
+			continue
+		}
+
+		msg, err := l.gateway.AddressGen(1, e.Index, false)
+		if err != nil || msg.Kind != uint16(messages.MessageType_MessageType_ResponseSkycoinAddress) {
+			return 0, false
+		}
+
+		addresses, err := skyWallet.DecodeResponseSkycoinAddress(msg)
+		if err != nil || len(addresses) != 1 || addresses[0] != address {
+			return 0, false
+		}
+
+		return e.Index, true
+	}
+
+	return 0, false
+}
+
+// resumable wraps the handler of an endpoint which resolves addresses, so that its request
+// can be served again if the device asks for a pin code or passphrase during the scan
+func (l *addressLookup) resumable(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		r.Body.Close() // nolint: errcheck
+		if err != nil {
+			resp := NewHTTPErrorResponse(http.StatusBadRequest, err.Error())
+			writeHTTPResponse(w, resp)
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		r = r.WithContext(context.WithValue(r.Context(), lookupRequestKey{}, &lookupRequest{
+			handler: handler,
+			method:  r.Method,
+			url:     r.URL.String(),
+			header:  r.Header,
+			body:    body,
+		}))
+
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// keep keeps the request pending if the device asked for user input during the scan
+func (l *addressLookup) keep(r *http.Request, msg wire.Message) {
+	if !isIntermediateMessage(msg) {
+		return
+	}
+
+	req, ok := r.Context().Value(lookupRequestKey{}).(*lookupRequest)
+	if !ok {
+		return
+	}
+
+	l.lock.Lock()
+	l.pending = req
+	l.lock.Unlock()
+}
+
+// interrupt forgets the pending request, it was replaced on the device by a new flow
+func (l *addressLookup) interrupt() {
+	l.lock.Lock()
+	l.pending = nil
+	l.lock.Unlock()
+}
+
+// failFlow forgets the pending request
+func (l *addressLookup) failFlow(err error) {
+	l.interrupt()
+}
+
+// resumeFlow serves the pending request again once the device derived the addresses of the scan,
+// the pin code and passphrase are cached by the device so the scan does not ask for them again
+func (l *addressLookup) resumeFlow(w http.ResponseWriter, r *http.Request, gateway Gatewayer, msg wire.Message) bool {
+	if isIntermediateMessage(msg) {
+		return false
+	}
+
+	l.lock.Lock()
+	pending := l.pending
+	l.pending = nil
+	l.lock.Unlock()
+
+	if pending == nil || msg.Kind != uint16(messages.MessageType_MessageType_ResponseSkycoinAddress) {
+		return false
+	}
+
+	req, err := http.NewRequest(pending.method, pending.url, bytes.NewReader(pending.body))
+	if err != nil {
+		logger.Errorf("addressLookup failed: %s", err.Error())
+		resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+		writeHTTPResponse(w, resp)
+		return true
+	}
+	req.Header = pending.header
+
+	l.resumable(pending.handler).ServeHTTP(w, req.WithContext(r.Context()))
+	return true
+}
+
+// resolve finds the index of address for an endpoint which accepts an address instead of an index.
+// If the index is not found, the response is written and false is returned.
+func (l *addressLookup) resolve(w http.ResponseWriter, r *http.Request, address string) (uint32, bool) {
+	if _, err := cipher.DecodeBase58Address(address); err != nil {
+		resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, fmt.Sprintf("invalid address: %v", err))
+		writeHTTPResponse(w, resp)
+		return 0, false
+	}
+
+	var index *uint32
+	var msg *wire.Message
+	var err error
+	doneCH := make(chan struct{})
+	ctx := r.Context()
+
+	go func() {
+		defer close(doneCH)
+		index, msg, err = l.find(ctx, address, l.limit)
+	}()
+
+	select {
+	case <-doneCH:
+		switch {
+		case err != nil:
+			logger.Errorf("addressLookup failed: %s", err.Error())
+			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+			writeHTTPResponse(w, resp)
+		case msg != nil:
+			// the device asked for a pin code or passphrase, or failed
+			l.keep(r, *msg)
+			HandleFirmwareResponseMessages(w, *msg)
+		case index == nil:
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, fmt.Sprintf("address %s not found in the first %d addresses of the device", address, l.limit))
+			writeHTTPResponse(w, resp)
+		default:
+			return *index, true
+		}
+	case <-ctx.Done():
+		disConnErr := l.gateway.Disconnect()
+		if disConnErr != nil {
+			resp := NewHTTPErrorResponse(http.StatusInternalServerError, disConnErr.Error())
+			writeHTTPResponse(w, resp)
+		} else {
+			resp := NewHTTPErrorResponse(499, "Client Closed Request")
+			writeHTTPResponse(w, resp)
+		}
+	}
+
+	return 0, false
+}
+
+// URI: /api/v1/address_index
+// Method: POST
+// Args: JSON Body
+func addressIndex(gateway Gatewayer, lookup *addressLookup) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if r.Header.Get("Content-Type") != ContentTypeJSON {
+			resp := NewHTTPErrorResponse(http.StatusUnsupportedMediaType, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		var req AddressIndexRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			resp := NewHTTPErrorResponse(http.StatusBadRequest, err.Error())
+			writeHTTPResponse(w, resp)
+			return
+		}
+		defer r.Body.Close()
+
+		if req.Address == "" {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, "address is required")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if _, err := cipher.DecodeBase58Address(req.Address); err != nil {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, fmt.Sprintf("invalid address: %v", err))
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if req.Limit == 0 {
+			req.Limit = int(lookup.limit)
+		}
+
+		if req.Limit < 0 || req.Limit > int(lookup.limit) {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, fmt.Sprintf("limit must be between 1 and %d", lookup.limit))
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		var index *uint32
+		var msg *wire.Message
+		var err error
+		retCH := make(chan int, 1)
+		errCH := make(chan int, 1)
+		ctx := r.Context()
+
+		go func() {
+			index, msg, err = lookup.find(ctx, req.Address, uint32(req.Limit))
+			if err != nil {
+				errCH <- 1
+				return
+			}
+			retCH <- 1
+		}()
+
+		select {
+		case <-retCH:
+			if msg != nil {
+				// the device asked for a pin code or passphrase, or failed
+				lookup.keep(r, *msg)
+				HandleFirmwareResponseMessages(w, *msg)
+				return
+			}
+
+			writeHTTPResponse(w, HTTPResponse{
+				Data: AddressIndexResponse{
+					Address: req.Address,
+					Found:   index != nil,
+					Index:   index,
+					Limit:   uint32(req.Limit),
+				},
+			})
+		case <-errCH:
+			logger.Errorf("addressIndex failed: %s", err.Error())
+			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+			writeHTTPResponse(w, resp)
+		case <-ctx.Done():
+			disConnErr := gateway.Disconnect()
+			if disConnErr != nil {
+				resp := NewHTTPErrorResponse(http.StatusInternalServerError, disConnErr.Error())
+				writeHTTPResponse(w, resp)
+			} else {
+				resp := NewHTTPErrorResponse(499, "Client Closed Request")
+				writeHTTPResponse(w, resp)
+			}
+		}
+	}
+}
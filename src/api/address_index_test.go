@@ -0,0 +1,424 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testLookupAddress = "2EU3JbveHdkxW6z5tdhbbB2kRAWvXC2pLzw"
+
+// mockAddressScan mocks the AddressGen batches of a scan of limit addresses, with testLookupAddress at index
+func mockAddressScan(t *testing.T, gateway *MockGatewayer, limit, index uint32) {
+	for start := uint32(0); start < limit; start += maxAddressN {
+		n := limit - start
+		if n > maxAddressN {
+			n = maxAddressN
+		}
+
+		addresses := make([]string, n)
+		for i := range addresses {
+			if start+uint32(i) == index {
+				addresses[i] = testLookupAddress
+			} else {
+				addresses[i] = fmt.Sprintf("address-%d", start+uint32(i))
+			}
+		}
+
+		gateway.On("AddressGen", n, start, false).Return(testAddressesMessage(t, addresses...), nil)
+	}
+}
+
+func TestAddressIndex(t *testing.T) {
+	index := uint32(120)
+
+	cases := []struct {
+		name         string
+		method       string
+		status       int
+		httpBody     string
+		scanIndex    uint32
+		firstBatch   *wire.Message
+		httpResponse HTTPResponse
+		result       *AddressIndexResponse
+	}{
+		{
+			name:         "405",
+			method:       http.MethodGet,
+			status:       http.StatusMethodNotAllowed,
+			httpResponse: NewHTTPErrorResponse(http.StatusMethodNotAllowed, ""),
+		},
+
+		{
+			name:         "422 - no address",
+			method:       http.MethodPost,
+			status:       http.StatusUnprocessableEntity,
+			httpBody:     `{}`,
+			httpResponse: newInvalidRequestResponse(".address in body is required"),
+		},
+
+		{
+			name:         "422 - invalid address",
+			method:       http.MethodPost,
+			status:       http.StatusUnprocessableEntity,
+			httpBody:     `{"address": "foo"}`,
+			httpResponse: NewHTTPErrorResponse(http.StatusUnprocessableEntity, "invalid address: Invalid address length"),
+		},
+
+		{
+			name:         "422 - limit too big",
+			method:       http.MethodPost,
+			status:       http.StatusUnprocessableEntity,
+			httpBody:     toJSON(t, AddressIndexRequest{Address: testLookupAddress, Limit: DefaultAddressLookupLimit + 1}),
+			httpResponse: NewHTTPErrorResponse(http.StatusUnprocessableEntity, "limit must be between 1 and 500"),
+		},
+
+		{
+			name:       "200 - PinMatrixRequest",
+			method:     http.MethodPost,
+			status:     http.StatusOK,
+			httpBody:   toJSON(t, AddressIndexRequest{Address: testLookupAddress, Limit: 150}),
+			firstBatch: &wire.Message{Kind: uint16(messages.MessageType_MessageType_PinMatrixRequest)},
+		},
+
+		{
+			name:      "200 - found",
+			method:    http.MethodPost,
+			status:    http.StatusOK,
+			httpBody:  toJSON(t, AddressIndexRequest{Address: testLookupAddress, Limit: 150}),
+			scanIndex: index,
+			result: &AddressIndexResponse{
+				Address: testLookupAddress,
+				Found:   true,
+				Index:   &index,
+				Limit:   150,
+			},
+		},
+
+		{
+			name:      "200 - not found",
+			method:    http.MethodPost,
+			status:    http.StatusOK,
+			httpBody:  toJSON(t, AddressIndexRequest{Address: testLookupAddress, Limit: 150}),
+			scanIndex: 200,
+			result: &AddressIndexResponse{
+				Address: testLookupAddress,
+				Limit:   150,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+			if tc.firstBatch != nil {
+				gateway.On("AddressGen", uint32(99), uint32(0), false).Return(*tc.firstBatch, nil)
+			} else {
+				mockAddressScan(t, gateway, 150, tc.scanIndex)
+			}
+
+			handler := newServerMux(defaultMuxConfig(), gateway)
+
+			rr := serveTestRequest(t, handler, tc.method, "/address_index", tc.httpBody, map[string]string{
+				"Content-Type": ContentTypeJSON,
+			})
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+
+			var rsp ReceivedHTTPResponse
+			err := json.NewDecoder(rr.Body).Decode(&rsp)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.httpResponse.Error, rsp.Error)
+
+			if tc.result != nil {
+				var result AddressIndexResponse
+				err = json.Unmarshal(rsp.Data, &result)
+				require.NoError(t, err)
+				require.Equal(t, *tc.result, result)
+			}
+		})
+	}
+}
+
+func TestAddressIndexCached(t *testing.T) {
+	addressBook, cleanup := newTestAddressBook(t)
+	defer cleanup()
+
+	require.NoError(t, addressBook.store("device1", "", 7, []string{testLookupAddress}))
+
+	gateway := &MockGatewayer{}
+	gateway.On("GetFeatures").Return(testFeaturesMessage(t, "device1", false), nil)
+
+	mc := defaultMuxConfig()
+	mc.addressBook = addressBook
+	body := toJSON(t, AddressIndexRequest{Address: testLookupAddress})
+
+	t.Run("verified by the device", func(t *testing.T) {
+		gateway.On("AddressGen", uint32(1), uint32(7), false).Return(testAddressesMessage(t, testLookupAddress), nil).Once()
+
+		handler := newServerMux(mc, gateway)
+		rr := serveTestRequest(t, handler, http.MethodPost, "/address_index", body, map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), `"index": 7`)
+		gateway.AssertNumberOfCalls(t, "AddressGen", 1)
+	})
+
+	t.Run("outdated cache is scanned", func(t *testing.T) {
+		gateway.On("AddressGen", uint32(1), uint32(7), false).Return(testAddressesMessage(t, "address-7"), nil).Once()
+		mockAddressScan(t, gateway, DefaultAddressLookupLimit, 42)
+
+		handler := newServerMux(mc, gateway)
+		rr := serveTestRequest(t, handler, http.MethodPost, "/address_index", body, map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), `"index": 42`)
+	})
+}
+
+func TestResolveAddressIndex(t *testing.T) {
+	mc := defaultMuxConfig()
+	mc.addressLookupLimit = 150
+
+	t.Run("sign_message", func(t *testing.T) {
+		gateway := &MockGatewayer{}
+		mockAddressScan(t, gateway, 150, 120)
+		gateway.On("SignMessage", 120, "foo").Return(testSuccessMessage(t, "signature"), nil)
+
+		handler := newServerMux(mc, gateway)
+		rr := serveTestRequest(t, handler, http.MethodPost, "/sign_message", toJSON(t, SignMessageRequest{
+			Address: testLookupAddress,
+			Message: "foo",
+		}), map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		gateway.AssertCalled(t, "SignMessage", 120, "foo")
+	})
+
+	t.Run("sign_message after pin", func(t *testing.T) {
+		gateway := &MockGatewayer{}
+		gateway.On("AddressGen", uint32(maxAddressN), uint32(0), false).Return(testPinMatrixRequestMessage(t, messages.PinMatrixRequestType_PinMatrixRequestType_Current), nil).Once()
+		gateway.On("PinMatrixAck", "1234").Return(testAddressesMessage(t, "address-0"), nil)
+		mockAddressScan(t, gateway, 150, 120)
+		gateway.On("SignMessage", 120, "foo").Return(testSuccessMessage(t, "signature"), nil)
+
+		handler := newServerMux(mc, gateway)
+		rr := serveTestRequest(t, handler, http.MethodPost, "/sign_message", toJSON(t, SignMessageRequest{
+			Address: testLookupAddress,
+			Message: "foo",
+		}), map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.JSONEq(t, `{"data":["PinMatrixRequest"],"intermediate":{"kind":"pin_matrix","operation":"sign_message","pin_matrix_request_type":"current"}}`, rr.Body.String())
+		gateway.AssertNotCalled(t, "SignMessage", 120, "foo")
+
+		// the address is looked up again and the message signed once the pin code is entered
+		rr = serveTestRequest(t, handler, http.MethodPost, "/intermediate/pin_matrix", `{"pin": "1234"}`, map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		gateway.AssertNumberOfCalls(t, "SignMessage", 1)
+		require.JSONEq(t, `{"data":["signature"]}`, rr.Body.String())
+	})
+
+	t.Run("sign_message wrong pin", func(t *testing.T) {
+		gateway := &MockGatewayer{}
+		gateway.On("AddressGen", uint32(maxAddressN), uint32(0), false).Return(testPinMatrixRequestMessage(t, messages.PinMatrixRequestType_PinMatrixRequestType_Current), nil).Once()
+		gateway.On("PinMatrixAck", "1234").Return(testFailureMessage(t, "PIN invalid"), nil)
+
+		handler := newServerMux(mc, gateway)
+		rr := serveTestRequest(t, handler, http.MethodPost, "/sign_message", toJSON(t, SignMessageRequest{
+			Address: testLookupAddress,
+			Message: "foo",
+		}), map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		rr = serveTestRequest(t, handler, http.MethodPost, "/intermediate/pin_matrix", `{"pin": "1234"}`, map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		gateway.AssertNumberOfCalls(t, "AddressGen", 1)
+		gateway.AssertNotCalled(t, "SignMessage", 120, "foo")
+	})
+
+	t.Run("sign_message address and address_n", func(t *testing.T) {
+		handler := newServerMux(mc, &MockGatewayer{})
+		rr := serveTestRequest(t, handler, http.MethodPost, "/sign_message", toJSON(t, SignMessageRequest{
+			AddressN: 1,
+			Address:  testLookupAddress,
+			Message:  "foo",
+		}), map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	t.Run("sign_message raw body without address_n", func(t *testing.T) {
+		gateway := &MockGatewayer{}
+		mockAddressScan(t, gateway, 150, 120)
+		gateway.On("SignMessage", 120, "foo").Return(testSuccessMessage(t, "signature"), nil)
+
+		handler := newServerMux(mc, gateway)
+		rr := serveTestRequest(t, handler, http.MethodPost, "/sign_message", `{"address": "`+testLookupAddress+`", "message": "foo"}`, map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		gateway.AssertCalled(t, "SignMessage", 120, "foo")
+	})
+
+	t.Run("sign_messages raw body without address_n", func(t *testing.T) {
+		gateway := &MockGatewayer{}
+		mockAddressScan(t, gateway, 150, 120)
+		gateway.On("SignMessage", 120, "foo").Return(testFailureMessage(t, "Action cancelled by user"), nil)
+
+		handler := newServerMux(mc, gateway)
+		rr := serveTestRequest(t, handler, http.MethodPost, "/sign_messages", `{"messages": [{"address": "`+testLookupAddress+`", "message": "foo"}]}`, map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		gateway.AssertCalled(t, "SignMessage", 120, "foo")
+	})
+
+	t.Run("sign_message raw body without address and address_n", func(t *testing.T) {
+		handler := newServerMux(mc, &MockGatewayer{})
+		rr := serveTestRequest(t, handler, http.MethodPost, "/sign_message", `{"message": "foo"}`, map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+		require.Contains(t, rr.Body.String(), "must validate one and only one schema (oneOf)")
+	})
+
+	t.Run("sign_message address not found", func(t *testing.T) {
+		gateway := &MockGatewayer{}
+		mockAddressScan(t, gateway, 150, 200)
+
+		handler := newServerMux(mc, gateway)
+		rr := serveTestRequest(t, handler, http.MethodPost, "/sign_message", toJSON(t, SignMessageRequest{
+			Address: testLookupAddress,
+			Message: "foo",
+		}), map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+		require.Contains(t, rr.Body.String(), "not found in the first 150 addresses")
+	})
+
+	t.Run("transaction_sign change output", func(t *testing.T) {
+		gateway := &MockGatewayer{}
+		mockAddressScan(t, gateway, 150, 3)
+		gateway.On("TransactionSign", mock.Anything, mock.MatchedBy(func(outputs []*messages.SkycoinTransactionOutput) bool {
+			return len(outputs) == 2 && outputs[0].AddressIndex == nil && outputs[1].GetAddressIndex() == 3
+		})).Return(testSuccessMessage(t, "signed"), nil)
+
+		inputIndex := uint32(0)
+		handler := newServerMux(mc, gateway)
+		rr := serveTestRequest(t, handler, http.MethodPost, "/transaction_sign", toJSON(t, TransactionSignRequest{
+			TransactionInputs: []TransactionInput{
+				{Index: &inputIndex, Hash: "cd9c6f70e7d2e4ae73c8bd38a6d4cba3d0ff5e7ea9bff90ba57ab1ea1dae75b5"},
+			},
+			TransactionOutputs: []TransactionOutput{
+				{Address: "zC8GAQGQBfwk7vtTxVoRG7iMperHNuyYPs", Coins: "1", Hours: "1"},
+				{Address: testLookupAddress, Coins: "2", Hours: "1", Change: true},
+			},
+		}), map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		gateway.AssertNumberOfCalls(t, "TransactionSign", 1)
+	})
+
+	t.Run("transaction_sign input address", func(t *testing.T) {
+		gateway := &MockGatewayer{}
+		mockAddressScan(t, gateway, 150, 7)
+		gateway.On("TransactionSign", mock.MatchedBy(func(inputs []*messages.SkycoinTransactionInput) bool {
+			return len(inputs) == 2 && inputs[0].GetIndex() == 0 && inputs[1].GetIndex() == 7
+		}), mock.Anything).Return(testSuccessMessage(t, "signed"), nil)
+
+		handler := newServerMux(mc, gateway)
+		rr := serveTestRequest(t, handler, http.MethodPost, "/transaction_sign", `{
+			"transaction_inputs": [
+				{"index": 0, "hash": "cd9c6f70e7d2e4ae73c8bd38a6d4cba3d0ff5e7ea9bff90ba57ab1ea1dae75b5"},
+				{"address": "`+testLookupAddress+`", "hash": "4f7250b0b1f588c4dedd5a4be984fab7215a773773480d8698e8f5ff04ef2611"}
+			],
+			"transaction_outputs": [{"address": "zC8GAQGQBfwk7vtTxVoRG7iMperHNuyYPs", "coins": "1", "hours": "1"}]
+		}`, map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		gateway.AssertNumberOfCalls(t, "TransactionSign", 1)
+	})
+
+	t.Run("transaction_sign input index and address", func(t *testing.T) {
+		inputIndex := uint32(7)
+		handler := newServerMux(mc, &MockGatewayer{})
+		rr := serveTestRequest(t, handler, http.MethodPost, "/transaction_sign", toJSON(t, TransactionSignRequest{
+			TransactionInputs: []TransactionInput{
+				{Index: &inputIndex, Address: testLookupAddress, Hash: "cd9c6f70e7d2e4ae73c8bd38a6d4cba3d0ff5e7ea9bff90ba57ab1ea1dae75b5"},
+			},
+			TransactionOutputs: []TransactionOutput{
+				{Address: "zC8GAQGQBfwk7vtTxVoRG7iMperHNuyYPs", Coins: "1", Hours: "1"},
+			},
+		}), map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	t.Run("transaction_sign raw input without index and address", func(t *testing.T) {
+		handler := newServerMux(mc, &MockGatewayer{})
+		rr := serveTestRequest(t, handler, http.MethodPost, "/transaction_sign", `{
+			"transaction_inputs": [{"hash": "cd9c6f70e7d2e4ae73c8bd38a6d4cba3d0ff5e7ea9bff90ba57ab1ea1dae75b5"}],
+			"transaction_outputs": [{"address": "zC8GAQGQBfwk7vtTxVoRG7iMperHNuyYPs", "coins": "1", "hours": "1"}]
+		}`, map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+		require.Contains(t, rr.Body.String(), "must validate one and only one schema (oneOf)")
+	})
+
+	t.Run("transaction_sign same address", func(t *testing.T) {
+		gateway := &MockGatewayer{}
+		mockAddressScan(t, gateway, 150, 7)
+		gateway.On("TransactionSign", mock.MatchedBy(func(inputs []*messages.SkycoinTransactionInput) bool {
+			return len(inputs) == 2 && inputs[0].GetIndex() == 7 && inputs[1].GetIndex() == 7
+		}), mock.MatchedBy(func(outputs []*messages.SkycoinTransactionOutput) bool {
+			return len(outputs) == 2 && outputs[1].GetAddressIndex() == 7
+		})).Return(testSuccessMessage(t, "signed"), nil)
+
+		handler := newServerMux(mc, gateway)
+		rr := serveTestRequest(t, handler, http.MethodPost, "/transaction_sign", toJSON(t, TransactionSignRequest{
+			TransactionInputs: []TransactionInput{
+				{Address: testLookupAddress, Hash: "cd9c6f70e7d2e4ae73c8bd38a6d4cba3d0ff5e7ea9bff90ba57ab1ea1dae75b5"},
+				{Address: testLookupAddress, Hash: "4f7250b0b1f588c4dedd5a4be984fab7215a773773480d8698e8f5ff04ef2611"},
+			},
+			TransactionOutputs: []TransactionOutput{
+				{Address: "zC8GAQGQBfwk7vtTxVoRG7iMperHNuyYPs", Coins: "1", Hours: "1"},
+				{Address: testLookupAddress, Coins: "2", Hours: "1", Change: true},
+			},
+		}), map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		// the address is found in the first batch, which is scanned once for the three uses
+		scans := 0
+		for _, call := range gateway.Calls {
+			if call.Method == "AddressGen" && call.Arguments.Get(1).(uint32) == 0 {
+				scans++
+			}
+		}
+		require.Equal(t, 1, scans)
+		gateway.AssertNumberOfCalls(t, "TransactionSign", 1)
+	})
+}
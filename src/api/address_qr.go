@@ -0,0 +1,193 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	"github.com/SkycoinProject/skycoin/src/util/droplet"
+
+	skyWallet "github.com/SkycoinProject/hardware-wallet-go/src/skywallet"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+
+	"github.com/skycoin/hardware-wallet-daemon/src/qrcode"
+)
+
+const (
+	// ContentTypePNG png content type header
+	ContentTypePNG = "image/png"
+	// ContentTypeSVG svg content type header
+	ContentTypeSVG = "image/svg+xml"
+
+	defaultQRScale = 8
+	maxQRScale     = 32
+)
+
+// paymentURI returns the skycoin payment URI of an address, with optional amount and label
+func paymentURI(address, amount, label string) string {
+	var params []string
+	if amount != "" {
+		params = append(params, "amount="+amount)
+	}
+	if label != "" {
+		params = append(params, "label="+strings.Replace(url.QueryEscape(label), "+", "%20", -1))
+	}
+
+	uri := "skycoin:" + address
+	if len(params) > 0 {
+		uri += "?" + strings.Join(params, "&")
+	}
+	return uri
+}
+
+// URI: /api/v1/addresses/:index/qr
+// Method: GET
+// Args:
+//  format: "png" or "svg", defaults to "png" [optional]
+//  amount: requested amount of coins, encodes a payment URI [optional]
+//  label: label of the address, encodes a payment URI [optional]
+//  scale: pixels per QR code module, defaults to 8 [optional]
+func addressQR(gateway Gatewayer, addressBook *AddressBook, maxAddressIndex uint32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/"+apiVersion1+"/addresses/"), "/")
+		if len(parts) != 2 || parts[1] != "qr" {
+			resp := NewHTTPErrorResponse(http.StatusNotFound, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if r.Method != http.MethodGet {
+			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		index, err := strconv.ParseUint(parts[0], 10, 32)
+		if err != nil {
+			resp := NewHTTPErrorResponse(http.StatusBadRequest, "invalid index")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if index > uint64(maxAddressIndex) {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, fmt.Sprintf("addresses above index %d cannot be generated", maxAddressIndex))
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		format := r.FormValue("format")
+		switch format {
+		case "":
+			format = "png"
+		case "png", "svg":
+		default:
+			resp := NewHTTPErrorResponse(http.StatusBadRequest, "format must be png or svg")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		scale := defaultQRScale
+		if s := r.FormValue("scale"); s != "" {
+			scale, err = strconv.Atoi(s)
+			if err != nil || scale < 1 || scale > maxQRScale {
+				resp := NewHTTPErrorResponse(http.StatusBadRequest, fmt.Sprintf("scale must be between 1 and %d", maxQRScale))
+				writeHTTPResponse(w, resp)
+				return
+			}
+		}
+
+		amount := r.FormValue("amount")
+		if amount != "" {
+			coins, err := droplet.FromString(amount)
+			if err != nil || coins == 0 {
+				resp := NewHTTPErrorResponse(http.StatusBadRequest, "invalid amount")
+				writeHTTPResponse(w, resp)
+				return
+			}
+
+			amount, err = droplet.ToString(coins)
+			if err != nil {
+				resp := NewHTTPErrorResponse(http.StatusBadRequest, "invalid amount")
+				writeHTTPResponse(w, resp)
+				return
+			}
+		}
+
+		label := r.FormValue("label")
+
+		addressBookDone := addressBook.addressGen(gateway, uint32(index))
+
+		var msg wire.Message
+		retCH := make(chan int)
+		errCH := make(chan int)
+		ctx := r.Context()
+
+		go func() {
+			msg, err = gateway.AddressGen(1, uint32(index), false)
+			if err != nil {
+				errCH <- 1
+				return
+			}
+			retCH <- 1
+		}()
+
+		select {
+		case <-retCH:
+			addressBook.finish(gateway, addressBookDone, msg)
+
+			if msg.Kind != uint16(messages.MessageType_MessageType_ResponseSkycoinAddress) {
+				HandleFirmwareResponseMessages(w, msg)
+				return
+			}
+
+			addresses, err := skyWallet.DecodeResponseSkycoinAddress(msg)
+			if err != nil || len(addresses) != 1 {
+				logger.Errorf("addressQR failed: unexpected device response: %v", err)
+				resp := NewHTTPErrorResponse(http.StatusInternalServerError, "device did not return one address")
+				writeHTTPResponse(w, resp)
+				return
+			}
+
+			qr, err := qrcode.Encode([]byte(paymentURI(addresses[0], amount, label)))
+			if err != nil {
+				resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, fmt.Sprintf("payment URI cannot be encoded: %v", err))
+				writeHTTPResponse(w, resp)
+				return
+			}
+
+			var data []byte
+			if format == "svg" {
+				w.Header().Set("Content-Type", ContentTypeSVG)
+				data = qr.SVG(scale)
+			} else {
+				data, err = qr.PNG(scale)
+				if err != nil {
+					resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+					writeHTTPResponse(w, resp)
+					return
+				}
+				w.Header().Set("Content-Type", ContentTypePNG)
+			}
+
+			if _, err := w.Write(data); err != nil {
+				logger.WithError(err).Error("http Write failed")
+			}
+		case <-errCH:
+			logger.Errorf("addressQR failed: %s", err.Error())
+			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+			writeHTTPResponse(w, resp)
+		case <-ctx.Done():
+			disConnErr := gateway.Disconnect()
+			if disConnErr != nil {
+				resp := NewHTTPErrorResponse(http.StatusInternalServerError, disConnErr.Error())
+				writeHTTPResponse(w, resp)
+			} else {
+				resp := NewHTTPErrorResponse(499, "Client Closed Request")
+				writeHTTPResponse(w, resp)
+			}
+		}
+	}
+}
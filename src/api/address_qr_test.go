@@ -0,0 +1,152 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"image/png"
+	"net/http"
+	"testing"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/hardware-wallet-daemon/src/qrcode"
+)
+
+func TestPaymentURI(t *testing.T) {
+	address := "2EU3JbveHdkxW6z5tdhbbB2kRAWvXC2pLzw"
+
+	require.Equal(t, "skycoin:"+address, paymentURI(address, "", ""))
+	require.Equal(t, "skycoin:"+address+"?amount=1.5", paymentURI(address, "1.5", ""))
+	require.Equal(t, "skycoin:"+address+"?amount=1.5&label=Coffee%20%26%20cake", paymentURI(address, "1.5", "Coffee & cake"))
+	require.Equal(t, "skycoin:"+address+"?label=shop", paymentURI(address, "", "shop"))
+}
+
+func TestAddressQR(t *testing.T) {
+	address := "2EU3JbveHdkxW6z5tdhbbB2kRAWvXC2pLzw"
+
+	cases := []struct {
+		name        string
+		method      string
+		endpoint    string
+		status      int
+		addressGen  wire.Message
+		contentType string
+		uri         string
+		err         *HTTPError
+	}{
+		{
+			name:     "405",
+			method:   http.MethodPost,
+			endpoint: "/addresses/3/qr",
+			status:   http.StatusMethodNotAllowed,
+			err:      NewHTTPErrorResponse(http.StatusMethodNotAllowed, "").Error,
+		},
+
+		{
+			name:     "404",
+			method:   http.MethodGet,
+			endpoint: "/addresses/3/png",
+			status:   http.StatusNotFound,
+			err:      NewHTTPErrorResponse(http.StatusNotFound, "").Error,
+		},
+
+		{
+			name:     "400 - invalid index",
+			method:   http.MethodGet,
+			endpoint: "/addresses/-1/qr",
+			status:   http.StatusBadRequest,
+			err:      NewHTTPErrorResponse(http.StatusBadRequest, "invalid index").Error,
+		},
+
+		{
+			name:     "422 - index too high",
+			method:   http.MethodGet,
+			endpoint: "/addresses/10001/qr",
+			status:   http.StatusUnprocessableEntity,
+			err:      NewHTTPErrorResponse(http.StatusUnprocessableEntity, "addresses above index 10000 cannot be generated").Error,
+		},
+
+		{
+			name:     "400 - invalid format",
+			method:   http.MethodGet,
+			endpoint: "/addresses/3/qr?format=gif",
+			status:   http.StatusBadRequest,
+			err:      NewHTTPErrorResponse(http.StatusBadRequest, "format must be png or svg").Error,
+		},
+
+		{
+			name:     "400 - invalid amount",
+			method:   http.MethodGet,
+			endpoint: "/addresses/3/qr?amount=1.2345678",
+			status:   http.StatusBadRequest,
+			err:      NewHTTPErrorResponse(http.StatusBadRequest, "invalid amount").Error,
+		},
+
+		{
+			name:       "200 - PinMatrixRequest",
+			method:     http.MethodGet,
+			endpoint:   "/addresses/3/qr",
+			status:     http.StatusOK,
+			addressGen: wire.Message{Kind: uint16(messages.MessageType_MessageType_PinMatrixRequest)},
+		},
+
+		{
+			name:        "200 - png",
+			method:      http.MethodGet,
+			endpoint:    "/addresses/3/qr",
+			status:      http.StatusOK,
+			addressGen:  testAddressesMessage(t, address),
+			contentType: ContentTypePNG,
+			uri:         "skycoin:" + address,
+		},
+
+		{
+			name:        "200 - svg payment URI",
+			method:      http.MethodGet,
+			endpoint:    "/addresses/3/qr?format=svg&amount=2&label=Coffee",
+			status:      http.StatusOK,
+			addressGen:  testAddressesMessage(t, address),
+			contentType: ContentTypeSVG,
+			uri:         "skycoin:" + address + "?amount=2.000000&label=Coffee",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+			gateway.On("AddressGen", uint32(1), uint32(3), false).Return(tc.addressGen, nil)
+
+			handler := newServerMux(defaultMuxConfig(), gateway)
+			rr := serveTestRequest(t, handler, tc.method, tc.endpoint, "", nil)
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+
+			if tc.contentType == "" {
+				var rsp ReceivedHTTPResponse
+				err := json.NewDecoder(rr.Body).Decode(&rsp)
+				require.NoError(t, err)
+				require.Equal(t, tc.err, rsp.Error)
+				return
+			}
+
+			require.Equal(t, tc.contentType, rr.Header().Get("Content-Type"))
+
+			qr, err := qrcode.Encode([]byte(tc.uri))
+			require.NoError(t, err)
+
+			if tc.contentType == ContentTypeSVG {
+				require.Equal(t, string(qr.SVG(defaultQRScale)), rr.Body.String())
+				return
+			}
+
+			img, err := png.Decode(bytes.NewReader(rr.Body.Bytes()))
+			require.NoError(t, err)
+			require.Equal(t, (qr.Size()+2*qrcode.QuietZone)*defaultQRScale, img.Bounds().Dx())
+
+			expected, err := qr.PNG(defaultQRScale)
+			require.NoError(t, err)
+			require.Equal(t, expected, rr.Body.Bytes())
+		})
+	}
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -100,6 +101,30 @@ func TestGenerateAddresses(t *testing.T) {
 			httpResponse: NewHTTPErrorResponse(http.StatusUnprocessableEntity, "start_index cannot be negative"),
 		},
 
+		{
+			name:        "422 - above max address index",
+			method:      http.MethodPost,
+			contentType: ContentTypeJSON,
+			status:      http.StatusUnprocessableEntity,
+			httpBody: toJSON(t, &GenerateAddressesRequest{
+				AddressN:   2,
+				StartIndex: DefaultMaxAddressIndex,
+			}),
+			httpResponse: NewHTTPErrorResponse(http.StatusUnprocessableEntity, "addresses above index 10000 cannot be generated"),
+		},
+
+		{
+			name:        "422 - ConfirmAddress batched",
+			method:      http.MethodPost,
+			contentType: ContentTypeJSON,
+			status:      http.StatusUnprocessableEntity,
+			httpBody: toJSON(t, &GenerateAddressesRequest{
+				AddressN:       100,
+				ConfirmAddress: true,
+			}),
+			httpResponse: NewHTTPErrorResponse(http.StatusUnprocessableEntity, "confirm_address cannot be used with more than 99 addresses or a streamed response"),
+		},
+
 		{
 			name:        "409 - Failure msg",
 			method:      http.MethodPost,
@@ -113,7 +138,7 @@ func TestGenerateAddresses(t *testing.T) {
 				Kind: uint16(messages.MessageType_MessageType_Failure),
 				Data: failureMsgBytes,
 			},
-			httpResponse: NewHTTPErrorResponse(http.StatusConflict, "failure msg"),
+			httpResponse: newTypedHTTPErrorResponse(http.StatusConflict, ErrorTypeNotInitialized, "failure msg"),
 		},
 
 		{
@@ -184,6 +209,164 @@ func TestGenerateAddresses(t *testing.T) {
 	}
 }
 
+func TestGenerateAddressesBatches(t *testing.T) {
+	testAddresses := func(startIndex, n int) []string {
+		addresses := make([]string, n)
+		for i := range addresses {
+			addresses[i] = fmt.Sprintf("address-%d", startIndex+i)
+		}
+		return addresses
+	}
+
+	body := toJSON(t, &GenerateAddressesRequest{
+		AddressN:   150,
+		StartIndex: 10,
+	})
+
+	t.Run("200 - batched", func(t *testing.T) {
+		gateway := &MockGatewayer{}
+		gateway.On("AddressGen", uint32(99), uint32(10), false).Return(testAddressesMessage(t, testAddresses(10, 99)...), nil)
+		gateway.On("AddressGen", uint32(51), uint32(109), false).Return(testAddressesMessage(t, testAddresses(109, 51)...), nil)
+
+		handler := newServerMux(defaultMuxConfig(), gateway)
+		rr := serveTestRequest(t, handler, http.MethodPost, "/generate_addresses", body, map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var rsp ReceivedHTTPResponse
+		err := json.NewDecoder(rr.Body).Decode(&rsp)
+		require.NoError(t, err)
+
+		var addresses []string
+		err = json.Unmarshal(rsp.Data, &addresses)
+		require.NoError(t, err)
+		require.Equal(t, testAddresses(10, 150), addresses)
+	})
+
+	t.Run("200 - PinMatrixRequest", func(t *testing.T) {
+		gateway := &MockGatewayer{}
+		gateway.On("AddressGen", uint32(99), uint32(10), false).Return(wire.Message{
+			Kind: uint16(messages.MessageType_MessageType_PinMatrixRequest),
+		}, nil)
+
+		handler := newServerMux(defaultMuxConfig(), gateway)
+		rr := serveTestRequest(t, handler, http.MethodPost, "/generate_addresses", body, map[string]string{
+			"Content-Type": ContentTypeJSON,
+			"Accept":       ContentTypeNDJSON,
+		})
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, ContentTypeJSON, rr.Header().Get("Content-Type"))
+		require.JSONEq(t, `{"data":["PinMatrixRequest"],"intermediate":{"kind":"pin_matrix","operation":"generate_addresses"}}`, rr.Body.String())
+	})
+
+	t.Run("200 - resumed after PinMatrixRequest", func(t *testing.T) {
+		addressBook, cleanup := newTestAddressBook(t)
+		defer cleanup()
+
+		gateway := &MockGatewayer{}
+		gateway.On("GetFeatures").Return(testFeaturesMessage(t, "DEVICE1", false), nil)
+		gateway.On("AddressGen", uint32(99), uint32(10), false).Return(testPinMatrixRequestMessage(t, messages.PinMatrixRequestType_PinMatrixRequestType_Current), nil).Once()
+		gateway.On("PinMatrixAck", "1234").Return(testAddressesMessage(t, testAddresses(10, 99)...), nil)
+		gateway.On("AddressGen", uint32(51), uint32(109), false).Return(testAddressesMessage(t, testAddresses(109, 51)...), nil)
+
+		mc := defaultMuxConfig()
+		mc.addressBook = addressBook
+		handler := newServerMux(mc, gateway)
+		rr := serveTestRequest(t, handler, http.MethodPost, "/generate_addresses", body, map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.JSONEq(t, `{"data":["PinMatrixRequest"],"intermediate":{"kind":"pin_matrix","operation":"generate_addresses","pin_matrix_request_type":"current"}}`, rr.Body.String())
+
+		// the remaining batches are generated once the pin code is entered
+		rr = serveTestRequest(t, handler, http.MethodPost, "/intermediate/pin_matrix", `{"pin": "1234"}`, map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		gateway.AssertNumberOfCalls(t, "AddressGen", 2)
+
+		var rsp ReceivedHTTPResponse
+		err := json.NewDecoder(rr.Body).Decode(&rsp)
+		require.NoError(t, err)
+
+		var addresses []string
+		err = json.Unmarshal(rsp.Data, &addresses)
+		require.NoError(t, err)
+		require.Equal(t, testAddresses(10, 150), addresses)
+
+		status, book := getAddressBook(t, handler)
+		require.Equal(t, http.StatusOK, status)
+		require.Len(t, book.Addresses, 150)
+	})
+
+	t.Run("409 - wrong pin", func(t *testing.T) {
+		gateway := &MockGatewayer{}
+		gateway.On("AddressGen", uint32(99), uint32(10), false).Return(testPinMatrixRequestMessage(t, messages.PinMatrixRequestType_PinMatrixRequestType_Current), nil).Once()
+		gateway.On("PinMatrixAck", "1234").Return(testFailureMessage(t, "PIN invalid"), nil)
+
+		handler := newServerMux(defaultMuxConfig(), gateway)
+		rr := serveTestRequest(t, handler, http.MethodPost, "/generate_addresses", body, map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		rr = serveTestRequest(t, handler, http.MethodPost, "/intermediate/pin_matrix", `{"pin": "1234"}`, map[string]string{
+			"Content-Type": ContentTypeJSON,
+		})
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		gateway.AssertNumberOfCalls(t, "AddressGen", 1)
+	})
+
+	t.Run("200 - streamed", func(t *testing.T) {
+		gateway := &MockGatewayer{}
+		gateway.On("AddressGen", uint32(99), uint32(10), false).Return(testAddressesMessage(t, testAddresses(10, 99)...), nil)
+		gateway.On("AddressGen", uint32(51), uint32(109), false).Return(testAddressesMessage(t, testAddresses(109, 51)...), nil)
+
+		handler := newServerMux(defaultMuxConfig(), gateway)
+		rr := serveTestRequest(t, handler, http.MethodPost, "/generate_addresses", body, map[string]string{
+			"Content-Type": ContentTypeJSON,
+			"Accept":       ContentTypeNDJSON,
+		})
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, ContentTypeNDJSON, rr.Header().Get("Content-Type"))
+
+		var batches []AddressBatch
+		dec := json.NewDecoder(rr.Body)
+		for dec.More() {
+			var batch AddressBatch
+			require.NoError(t, dec.Decode(&batch))
+			batches = append(batches, batch)
+		}
+
+		require.Equal(t, []AddressBatch{
+			{StartIndex: 10, Addresses: testAddresses(10, 99)},
+			{StartIndex: 109, Addresses: testAddresses(109, 51)},
+		}, batches)
+	})
+
+	t.Run("200 - streamed failure", func(t *testing.T) {
+		gateway := &MockGatewayer{}
+		gateway.On("AddressGen", uint32(99), uint32(10), false).Return(testAddressesMessage(t, testAddresses(10, 99)...), nil)
+		gateway.On("AddressGen", uint32(51), uint32(109), false).Return(testFailureMessage(t, "Action cancelled by user"), nil)
+
+		handler := newServerMux(defaultMuxConfig(), gateway)
+		rr := serveTestRequest(t, handler, http.MethodPost, "/generate_addresses", body, map[string]string{
+			"Content-Type": ContentTypeJSON,
+			"Accept":       ContentTypeNDJSON,
+		})
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		lines := strings.Split(strings.TrimSpace(rr.Body.String()), "\n")
+		require.Len(t, lines, 2)
+
+		var rsp ReceivedHTTPResponse
+		err := json.Unmarshal([]byte(lines[1]), &rsp)
+		require.NoError(t, err)
+		require.Equal(t, newTypedHTTPErrorResponse(http.StatusConflict, ErrorTypeActionCancelled, "Action cancelled by user").Error, rsp.Error)
+	})
+}
+
 func toJSON(t *testing.T, r interface{}) string {
 	b, err := json.Marshal(r)
 	require.NoError(t, err)
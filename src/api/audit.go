@@ -0,0 +1,485 @@
+package api
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+
+	skyWallet "github.com/SkycoinProject/hardware-wallet-go/src/skywallet"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+)
+
+const (
+	// ContentTypeCSV csv content type header
+	ContentTypeCSV = "text/csv"
+
+	// AuditLogFilename is the name of the audit log file in the data directory
+	AuditLogFilename = "audit.log"
+)
+
+// Results recorded by the daemon for audited operations
+const (
+	auditResultSuccess     = "Success"
+	auditResultError       = "Error"
+	auditResultInterrupted = "Interrupted"
+	auditResultClosed      = "ClientClosedRequest"
+)
+
+// AuditEntry is a single record of a sensitive device operation
+type AuditEntry struct {
+	Time      time.Time       `json:"time"`
+	Operation string          `json:"operation"`
+	Origin    string          `json:"origin"`
+	Referer   string          `json:"referer"`
+	DeviceID  string          `json:"device_id"`
+	Request   json.RawMessage `json:"request,omitempty"`
+	Result    string          `json:"result"`
+	Message   string          `json:"message,omitempty"`
+}
+
+// AuditLog is an append-only log of sensitive device operations.
+// Entries are stored as one JSON object per line.
+// Operations which need intermediate user input are kept pending
+// until the firmware sends a final response.
+type AuditLog struct {
+	path    string
+	lock    sync.Mutex
+	pending *AuditEntry
+}
+
+// NewAuditLog creates an AuditLog writing to path
+func NewAuditLog(path string) *AuditLog {
+	return &AuditLog{
+		path: path,
+	}
+}
+
+// begin starts an audit entry for an operation. The device id is read from
+// the device features before the operation is sent. A previously pending
+// operation is recorded as interrupted.
+func (a *AuditLog) begin(r *http.Request, gateway Gatewayer, operation string, summary interface{}) *AuditEntry {
+	return a.beginFrom(r.Header.Get("Origin"), r.Header.Get("Referer"), gateway, operation, summary)
+}
+
+// beginFrom starts an audit entry for an operation requested from origin and referer, like begin
+func (a *AuditLog) beginFrom(origin, referer string, gateway Gatewayer, operation string, summary interface{}) *AuditEntry {
+	if a == nil {
+		return nil
+	}
+
+	a.interrupt()
+
+	entry := &AuditEntry{
+		Time:      time.Now().UTC(),
+		Operation: operation,
+		Origin:    origin,
+		Referer:   referer,
+		DeviceID:  auditDeviceID(gateway),
+	}
+
+	if summary != nil {
+		req, err := json.Marshal(summary)
+		if err != nil {
+			logger.WithError(err).Errorf("audit: failed to encode %s request summary", operation)
+		} else {
+			entry.Request = req
+		}
+	}
+
+	return entry
+}
+
+// finish records the firmware response of an operation started with begin.
+// If the firmware asks for user input the entry is kept pending.
+func (a *AuditLog) finish(entry *AuditEntry, msg wire.Message) {
+	if a == nil || entry == nil {
+		return
+	}
+
+	if isIntermediateMessage(msg) {
+		a.lock.Lock()
+		a.pending = entry
+		a.lock.Unlock()
+		return
+	}
+
+	entry.Result, entry.Message = auditResult(msg)
+	a.write(entry)
+}
+
+// finishWithResult records an operation with a result set by the daemon
+func (a *AuditLog) finishWithResult(entry *AuditEntry, result, message string) {
+	if a == nil || entry == nil {
+		return
+	}
+
+	entry.Result = result
+	entry.Message = message
+	a.write(entry)
+}
+
+// resume records the firmware response of an intermediate request
+// against the pending operation, if there is one
+func (a *AuditLog) resume(msg wire.Message) {
+	if a == nil {
+		return
+	}
+
+	a.lock.Lock()
+	entry := a.pending
+	if entry == nil || isIntermediateMessage(msg) {
+		a.lock.Unlock()
+		return
+	}
+	a.pending = nil
+	a.lock.Unlock()
+
+	entry.Result, entry.Message = auditResult(msg)
+	a.write(entry)
+}
+
+// resumeWithResult records a daemon side result against the pending operation, if there is one
+func (a *AuditLog) resumeWithResult(result, message string) {
+	if a == nil {
+		return
+	}
+
+	a.lock.Lock()
+	entry := a.pending
+	a.pending = nil
+	a.lock.Unlock()
+
+	a.finishWithResult(entry, result, message)
+}
+
+// interrupt records the pending operation as interrupted, if there is one
+func (a *AuditLog) interrupt() {
+	a.resumeWithResult(auditResultInterrupted, "")
+}
+
+// resumeFlow records the firmware response of an intermediate request, it does not write the response
+func (a *AuditLog) resumeFlow(w http.ResponseWriter, r *http.Request, gateway Gatewayer, msg wire.Message) bool {
+	a.resume(msg)
+	return false
+}
+
+// failFlow records the pending operation as failed
+func (a *AuditLog) failFlow(err error) {
+	a.resumeWithResult(auditResultError, err.Error())
+}
+
+// cancelFlow records the answer of the device to a cancel request against the pending operation
+func (a *AuditLog) cancelFlow(gateway Gatewayer, msg wire.Message) {
+	a.resume(msg)
+}
+
+func (a *AuditLog) write(entry *AuditEntry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		logger.WithError(err).Error("audit: failed to encode entry")
+		return
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	f, err := os.OpenFile(a.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		logger.WithError(err).Errorf("audit: os.OpenFile(%s) failed", a.path)
+		return
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		logger.WithError(err).Errorf("audit: failed to write to %s", a.path)
+	}
+}
+
+// AuditFilter selects audit entries. Empty fields match everything.
+type AuditFilter struct {
+	Operation string
+	DeviceID  string
+	Origin    string
+	Since     time.Time
+	Until     time.Time
+}
+
+func (f AuditFilter) match(e AuditEntry) bool {
+	if f.Operation != "" && f.Operation != e.Operation {
+		return false
+	}
+
+	if f.DeviceID != "" && f.DeviceID != e.DeviceID {
+		return false
+	}
+
+	if f.Origin != "" && f.Origin != e.Origin && f.Origin != e.Referer {
+		return false
+	}
+
+	if !f.Since.IsZero() && e.Time.Before(f.Since) {
+		return false
+	}
+
+	if !f.Until.IsZero() && e.Time.After(f.Until) {
+		return false
+	}
+
+	return true
+}
+
+// Entries returns the recorded entries matching the filter, oldest first
+func (a *AuditLog) Entries(filter AuditFilter) ([]AuditEntry, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	entries := []AuditEntry{}
+
+	f, err := os.Open(a.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return entries, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("invalid audit log entry: %v", err)
+		}
+
+		if filter.match(e) {
+			entries = append(entries, e)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// URI: /api/v1/audit
+// Method: GET
+// Args:
+//  operation: filter by operation [optional]
+//  device_id: filter by device id [optional]
+//  origin: filter by Origin or Referer header [optional]
+//  since: RFC3339 time of the oldest entry [optional]
+//  until: RFC3339 time of the newest entry [optional]
+//  format: "json" or "csv", defaults to "json" [optional]
+func auditHandler(auditLog *AuditLog) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if auditLog == nil {
+			resp := NewHTTPErrorResponse(http.StatusForbidden, "audit log is disabled")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		query := r.URL.Query()
+		filter := AuditFilter{
+			Operation: query.Get("operation"),
+			DeviceID:  query.Get("device_id"),
+			Origin:    query.Get("origin"),
+		}
+
+		var err error
+		if since := query.Get("since"); since != "" {
+			filter.Since, err = time.Parse(time.RFC3339, since)
+			if err != nil {
+				resp := NewHTTPErrorResponse(http.StatusBadRequest, "invalid value for since")
+				writeHTTPResponse(w, resp)
+				return
+			}
+		}
+
+		if until := query.Get("until"); until != "" {
+			filter.Until, err = time.Parse(time.RFC3339, until)
+			if err != nil {
+				resp := NewHTTPErrorResponse(http.StatusBadRequest, "invalid value for until")
+				writeHTTPResponse(w, resp)
+				return
+			}
+		}
+
+		format := query.Get("format")
+		switch format {
+		case "", "json", "csv":
+		default:
+			resp := NewHTTPErrorResponse(http.StatusBadRequest, "format must be json or csv")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		entries, err := auditLog.Entries(filter)
+		if err != nil {
+			logger.WithError(err).Error("audit: failed to read entries")
+			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if format == "csv" {
+			writeAuditCSV(w, entries)
+			return
+		}
+
+		writeHTTPResponse(w, HTTPResponse{
+			Data: entries,
+		})
+	}
+}
+
+func writeAuditCSV(w http.ResponseWriter, entries []AuditEntry) {
+	w.Header().Set("Content-Type", ContentTypeCSV)
+	w.Header().Set("Content-Disposition", `attachment; filename="audit.csv"`)
+	w.WriteHeader(http.StatusOK)
+
+	cw := csv.NewWriter(w)
+	records := [][]string{
+		{"time", "operation", "origin", "referer", "device_id", "request", "result", "message"},
+	}
+	for _, e := range entries {
+		records = append(records, []string{
+			e.Time.Format(time.RFC3339),
+			e.Operation,
+			e.Origin,
+			e.Referer,
+			e.DeviceID,
+			string(e.Request),
+			e.Result,
+			e.Message,
+		})
+	}
+
+	if err := cw.WriteAll(records); err != nil {
+		logger.WithError(err).Error("audit: csv write failed")
+	}
+}
+
+// auditDeviceID returns the device id reported by the device features,
+// or an empty string if the features can't be read
+func auditDeviceID(gateway Gatewayer) string {
+	features, err := deviceFeatures(gateway)
+	if err != nil {
+		logger.WithError(err).Warning("audit: failed to get device features")
+		return ""
+	}
+
+	return features.GetDeviceId()
+}
+
+// auditResult returns the result and message recorded for a firmware response
+func auditResult(msg wire.Message) (string, string) {
+	result := strings.TrimPrefix(messages.MessageType(msg.Kind).String(), "MessageType_")
+
+	switch msg.Kind {
+	case uint16(messages.MessageType_MessageType_Success):
+		successMsg, err := skyWallet.DecodeSuccessMsg(msg)
+		if err != nil {
+			return auditResultError, err.Error()
+		}
+		return result, successMsg
+	case uint16(messages.MessageType_MessageType_Failure):
+		failureMsg, err := skyWallet.DecodeFailMsg(msg)
+		if err != nil {
+			return auditResultError, err.Error()
+		}
+		return result, failureMsg
+	default:
+		return result, ""
+	}
+}
+
+// isIntermediateMessage reports whether the firmware is asking for user input
+func isIntermediateMessage(msg wire.Message) bool {
+	switch msg.Kind {
+	case uint16(messages.MessageType_MessageType_PinMatrixRequest),
+		uint16(messages.MessageType_MessageType_PassphraseRequest),
+		uint16(messages.MessageType_MessageType_WordRequest),
+		uint16(messages.MessageType_MessageType_ButtonRequest),
+		uint16(messages.MessageType_MessageType_PassphraseStateRequest):
+		return true
+	default:
+		return false
+	}
+}
+
+// auditTransactionOutput is the audit summary of a transaction output
+type auditTransactionOutput struct {
+	Address      string  `json:"address"`
+	AddressIndex *uint32 `json:"address_index,omitempty"`
+	Coins        string  `json:"coins"`
+	Hours        string  `json:"hours"`
+}
+
+// auditTransactionSign is the audit summary of a transaction sign request
+type auditTransactionSign struct {
+	Inputs  []string                 `json:"inputs"`
+	Outputs []auditTransactionOutput `json:"outputs"`
+}
+
+func newAuditTransactionSign(req TransactionSignRequest) auditTransactionSign {
+	summary := auditTransactionSign{
+		Inputs:  make([]string, len(req.TransactionInputs)),
+		Outputs: make([]auditTransactionOutput, len(req.TransactionOutputs)),
+	}
+
+	for i, in := range req.TransactionInputs {
+		summary.Inputs[i] = in.Hash
+	}
+
+	for i, out := range req.TransactionOutputs {
+		summary.Outputs[i] = auditTransactionOutput{
+			Address:      out.Address,
+			AddressIndex: out.AddressIndex,
+			Coins:        out.Coins,
+			Hours:        out.Hours,
+		}
+	}
+
+	return summary
+}
+
+// auditSignMessage is the audit summary of a sign message request
+type auditSignMessage struct {
+	AddressN int    `json:"address_n"`
+	Message  string `json:"message"`
+}
+
+// auditSetMnemonic is the audit summary of a set mnemonic request. The mnemonic itself is never recorded.
+type auditSetMnemonic struct {
+	WordCount int `json:"word_count"`
+}
+
+// auditLoadDevice is the audit summary of a load device request. The mnemonic and pin code are never recorded.
+type auditLoadDevice struct {
+	WordCount            int    `json:"word_count"`
+	PinProtection        bool   `json:"pin_protection"`
+	PassphraseProtection bool   `json:"passphrase_protection"`
+	Label                string `json:"label,omitempty"`
+}
+
+// auditFirmwareUpdate is the audit summary of a firmware update request
+type auditFirmwareUpdate struct {
+	Size int    `json:"size"`
+	Hash string `json:"hash"`
+}
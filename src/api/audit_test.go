@@ -0,0 +1,362 @@
+package api
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAuditLog(t *testing.T) (*AuditLog, func()) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+
+	return NewAuditLog(filepath.Join(dir, AuditLogFilename)), func() {
+		os.RemoveAll(dir)
+	}
+}
+
+func testFeaturesMessage(t *testing.T, deviceID string, usePassphrase bool) wire.Message {
+	features := messages.Features{
+		DeviceId:             newStrPtr(deviceID),
+		PassphraseProtection: newBoolPtr(usePassphrase),
+	}
+
+	data, err := features.Marshal()
+	require.NoError(t, err)
+
+	return wire.Message{
+		Kind: uint16(messages.MessageType_MessageType_Features),
+		Data: data,
+	}
+}
+
+func testSuccessMessage(t *testing.T, msg string) wire.Message {
+	success := messages.Success{
+		Message: newStrPtr(msg),
+	}
+
+	data, err := success.Marshal()
+	require.NoError(t, err)
+
+	return wire.Message{
+		Kind: uint16(messages.MessageType_MessageType_Success),
+		Data: data,
+	}
+}
+
+func testFailureMessage(t *testing.T, msg string) wire.Message {
+	failure := messages.Failure{
+		Code:    messages.FailureType_Failure_ActionCancelled.Enum(),
+		Message: newStrPtr(msg),
+	}
+
+	data, err := failure.Marshal()
+	require.NoError(t, err)
+
+	return wire.Message{
+		Kind: uint16(messages.MessageType_MessageType_Failure),
+		Data: data,
+	}
+}
+
+var buttonRequestMessage = wire.Message{
+	Kind: uint16(messages.MessageType_MessageType_ButtonRequest),
+}
+
+func serveTestRequest(t *testing.T, handler http.Handler, method, endpoint, body string, headers map[string]string) *httptest.ResponseRecorder {
+	req, err := http.NewRequest(method, "/api/v1"+endpoint, strings.NewReader(body))
+	require.NoError(t, err)
+
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestAuditLogIntermediateFlow(t *testing.T) {
+	auditLog, cleanup := newTestAuditLog(t)
+	defer cleanup()
+
+	gateway := &MockGatewayer{}
+	gateway.On("GetFeatures").Return(testFeaturesMessage(t, "device-1", false), nil)
+	gateway.On("Wipe").Return(buttonRequestMessage, nil)
+	gateway.On("ButtonAck").Return(testSuccessMessage(t, "Device wiped"), nil)
+
+	mc := defaultMuxConfig()
+	mc.auditLog = auditLog
+	handler := newServerMux(mc, gateway)
+
+	headers := map[string]string{
+		"Origin": "http://127.0.0.1:8000",
+	}
+
+	rr := serveTestRequest(t, handler, http.MethodDelete, "/wipe", "", headers)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// the entry is pending until the firmware sends the final response
+	entries, err := auditLog.Entries(AuditFilter{})
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	rr = serveTestRequest(t, handler, http.MethodPost, "/intermediate/button", "", nil)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	entries, err = auditLog.Entries(AuditFilter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "wipe", entries[0].Operation)
+	require.Equal(t, "http://127.0.0.1:8000", entries[0].Origin)
+	require.Equal(t, "device-1", entries[0].DeviceID)
+	require.Equal(t, "Success", entries[0].Result)
+	require.Equal(t, "Device wiped", entries[0].Message)
+	require.Nil(t, entries[0].Request)
+}
+
+func TestAuditLogInterrupted(t *testing.T) {
+	auditLog, cleanup := newTestAuditLog(t)
+	defer cleanup()
+
+	gateway := &MockGatewayer{}
+	gateway.On("GetFeatures").Return(testFeaturesMessage(t, "device-1", false), nil)
+	gateway.On("SignMessage", 1, "foo").Return(buttonRequestMessage, nil)
+	gateway.On("Backup").Return(buttonRequestMessage, nil)
+	gateway.On("ButtonAck").Return(testSuccessMessage(t, "Seed successfully backed up"), nil)
+
+	mc := defaultMuxConfig()
+	mc.auditLog = auditLog
+	handler := newServerMux(mc, gateway)
+
+	headers := map[string]string{
+		"Content-Type": ContentTypeJSON,
+	}
+
+	rr := serveTestRequest(t, handler, http.MethodPost, "/sign_message", `{"address_n": 1, "message": "foo"}`, headers)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// a backup replaces the pending sign message flow on the device
+	rr = serveTestRequest(t, handler, http.MethodPost, "/backup", "", nil)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	rr = serveTestRequest(t, handler, http.MethodPost, "/intermediate/button", "", nil)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	entries, err := auditLog.Entries(AuditFilter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "sign_message", entries[0].Operation)
+	require.Equal(t, auditResultInterrupted, entries[0].Result)
+	require.JSONEq(t, `{"address_n": 1, "message": "foo"}`, string(entries[0].Request))
+}
+
+func TestAuditLogDeviceReplaced(t *testing.T) {
+	auditLog, cleanup := newTestAuditLog(t)
+	defer cleanup()
+
+	gateway := &MockGatewayer{}
+	gateway.On("GetFeatures").Return(testFeaturesMessage(t, "device-1", false), nil).Once()
+	gateway.On("SignMessage", 1, "foo").Return(testSuccessMessage(t, "signature"), nil)
+
+	mc := defaultMuxConfig()
+	mc.auditLog = auditLog
+	handler := newServerMux(mc, gateway)
+
+	headers := map[string]string{
+		"Content-Type": ContentTypeJSON,
+	}
+
+	rr := serveTestRequest(t, handler, http.MethodPost, "/sign_message", `{"address_n": 1, "message": "foo"}`, headers)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// another device is plugged in after a successful operation
+	gateway.On("GetFeatures").Return(testFeaturesMessage(t, "device-2", false), nil).Once()
+
+	rr = serveTestRequest(t, handler, http.MethodPost, "/sign_message", `{"address_n": 1, "message": "foo"}`, headers)
+	require.Equal(t, http.StatusOK, rr.Code)
+	gateway.AssertExpectations(t)
+
+	entries, err := auditLog.Entries(AuditFilter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "device-1", entries[0].DeviceID)
+	require.Equal(t, "device-2", entries[1].DeviceID)
+}
+
+func TestAuditLogSecretsRemoved(t *testing.T) {
+	auditLog, cleanup := newTestAuditLog(t)
+	defer cleanup()
+
+	mnemonic := "cloud flower upset remain green metal below cup stem infant art thank"
+
+	gateway := &MockGatewayer{}
+	gateway.On("GetFeatures").Return(testFeaturesMessage(t, "device-1", false), nil)
+	gateway.On("SetMnemonic", mnemonic).Return(testFailureMessage(t, "Action cancelled by user"), nil)
+	gateway.On("PinMatrixAck", "1234").Return(testSuccessMessage(t, "PIN changed"), nil)
+
+	mc := defaultMuxConfig()
+	mc.auditLog = auditLog
+	handler := newServerMux(mc, gateway)
+
+	headers := map[string]string{
+		"Content-Type": ContentTypeJSON,
+	}
+
+	rr := serveTestRequest(t, handler, http.MethodPost, "/set_mnemonic", toJSON(t, SetMnemonicRequest{Mnemonic: mnemonic}), headers)
+	require.Equal(t, http.StatusConflict, rr.Code)
+
+	rr = serveTestRequest(t, handler, http.MethodPost, "/intermediate/pin_matrix", `{"pin": "1234"}`, headers)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	data, err := ioutil.ReadFile(auditLog.path)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "cloud flower")
+
+	entries, err := auditLog.Entries(AuditFilter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "set_mnemonic", entries[0].Operation)
+	require.Equal(t, "Failure", entries[0].Result)
+	require.Equal(t, "Action cancelled by user", entries[0].Message)
+	require.JSONEq(t, `{"word_count": 12}`, string(entries[0].Request))
+}
+
+func TestAuditHandler(t *testing.T) {
+	auditLog, cleanup := newTestAuditLog(t)
+	defer cleanup()
+
+	gateway := &MockGatewayer{}
+	gateway.On("GetFeatures").Return(testFeaturesMessage(t, "device-1", false), nil).Once()
+	gateway.On("GetFeatures").Return(testFeaturesMessage(t, "device-2", false), nil).Once()
+	gateway.On("Wipe").Return(testSuccessMessage(t, "Device wiped"), nil)
+
+	mc := defaultMuxConfig()
+	mc.auditLog = auditLog
+	handler := newServerMux(mc, gateway)
+
+	for i := 0; i < 2; i++ {
+		rr := serveTestRequest(t, handler, http.MethodDelete, "/wipe", "", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+	}
+
+	cases := []struct {
+		name      string
+		method    string
+		query     string
+		status    int
+		err       *HTTPError
+		deviceIDs []string
+	}{
+		{
+			name:   "405",
+			method: http.MethodPost,
+			status: http.StatusMethodNotAllowed,
+			err:    NewHTTPErrorResponse(http.StatusMethodNotAllowed, "").Error,
+		},
+
+		{
+			name:   "400 - invalid since",
+			method: http.MethodGet,
+			query:  "?since=yesterday",
+			status: http.StatusBadRequest,
+			err:    NewHTTPErrorResponse(http.StatusBadRequest, "invalid value for since").Error,
+		},
+
+		{
+			name:   "400 - invalid format",
+			method: http.MethodGet,
+			query:  "?format=xml",
+			status: http.StatusBadRequest,
+			err:    NewHTTPErrorResponse(http.StatusBadRequest, "format must be json or csv").Error,
+		},
+
+		{
+			name:      "200 - all",
+			method:    http.MethodGet,
+			status:    http.StatusOK,
+			deviceIDs: []string{"device-1", "device-2"},
+		},
+
+		{
+			name:      "200 - device_id filter",
+			method:    http.MethodGet,
+			query:     "?device_id=device-2",
+			status:    http.StatusOK,
+			deviceIDs: []string{"device-2"},
+		},
+
+		{
+			name:      "200 - operation filter",
+			method:    http.MethodGet,
+			query:     "?operation=sign_message",
+			status:    http.StatusOK,
+			deviceIDs: []string{},
+		},
+
+		{
+			name:      "200 - until filter",
+			method:    http.MethodGet,
+			query:     "?until=2019-01-01T00:00:00Z",
+			status:    http.StatusOK,
+			deviceIDs: []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := serveTestRequest(t, handler, tc.method, "/audit"+tc.query, "", nil)
+			require.Equal(t, tc.status, rr.Code)
+
+			var rsp ReceivedHTTPResponse
+			err := json.NewDecoder(rr.Body).Decode(&rsp)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.err, rsp.Error)
+
+			if tc.err != nil {
+				return
+			}
+
+			var entries []AuditEntry
+			err = json.Unmarshal(rsp.Data, &entries)
+			require.NoError(t, err)
+
+			deviceIDs := []string{}
+			for _, e := range entries {
+				deviceIDs = append(deviceIDs, e.DeviceID)
+			}
+			require.Equal(t, tc.deviceIDs, deviceIDs)
+		})
+	}
+
+	t.Run("200 - csv", func(t *testing.T) {
+		rr := serveTestRequest(t, handler, http.MethodGet, "/audit?format=csv", "", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, ContentTypeCSV, rr.Header().Get("Content-Type"))
+
+		records, err := csv.NewReader(rr.Body).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		require.Equal(t, "operation", records[0][1])
+		require.Equal(t, "wipe", records[1][1])
+		require.Equal(t, "device-2", records[2][4])
+		require.Equal(t, "Device wiped", records[2][7])
+	})
+
+	t.Run("403 - disabled", func(t *testing.T) {
+		handler := newServerMux(defaultMuxConfig(), gateway)
+		rr := serveTestRequest(t, handler, http.MethodGet, "/audit", "", nil)
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
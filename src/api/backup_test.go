@@ -43,7 +43,7 @@ func TestBackup(t *testing.T) {
 				Kind: uint16(messages.MessageType_MessageType_Failure),
 				Data: failureMsgBytes,
 			},
-			httpResponse: NewHTTPErrorResponse(http.StatusConflict, "failure msg"),
+			httpResponse: newTypedHTTPErrorResponse(http.StatusConflict, ErrorTypeNotInitialized, "failure msg"),
 		},
 	}
 
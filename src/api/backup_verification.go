@@ -0,0 +1,299 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	"github.com/gogo/protobuf/proto"
+
+	skyWallet "github.com/SkycoinProject/hardware-wallet-go/src/skywallet"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+)
+
+// Statuses of a backup verification
+const (
+	backupVerificationNone         = "none"
+	backupVerificationInProgress   = "in_progress"
+	backupVerificationMatches      = "matches"
+	backupVerificationDoesNotMatch = "does_not_match"
+	backupVerificationCancelled    = "cancelled"
+	backupVerificationInterrupted  = "interrupted"
+	backupVerificationFailed       = "failed"
+)
+
+// BackupVerifyRequest is request data for POST /api/v1/backup/verify
+type BackupVerifyRequest struct {
+	WordCount uint32 `json:"word_count"`
+}
+
+// BackupVerification is the status of the last backup verification, returned by /api/v1/backup/verify
+type BackupVerification struct {
+	Status       string `json:"status"`
+	WordCount    uint32 `json:"word_count,omitempty"`
+	WordsEntered int    `json:"words_entered"`
+	// Message is the firmware message of the result
+	Message string `json:"message,omitempty"`
+	// NeedsBackup is read from the device features once the verification ends
+	NeedsBackup *bool `json:"needs_backup,omitempty"`
+}
+
+// backupVerification tracks a dry-run recovery which checks the seed backup of the device
+// against the seed of the device. The words entered through /api/v1/intermediate/word are counted.
+type backupVerification struct {
+	lock   sync.Mutex
+	status BackupVerification
+}
+
+func newBackupVerification() *backupVerification {
+	return &backupVerification{
+		status: BackupVerification{
+			Status: backupVerificationNone,
+		},
+	}
+}
+
+// get returns the status of the last verification
+func (b *backupVerification) get() BackupVerification {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.status
+}
+
+// begin starts tracking a verification
+func (b *backupVerification) begin(wordCount uint32) {
+	b.lock.Lock()
+	b.status = BackupVerification{
+		Status:    backupVerificationInProgress,
+		WordCount: wordCount,
+	}
+	b.lock.Unlock()
+}
+
+// pending returns true if a verification is waiting for user input
+func (b *backupVerification) pending() bool {
+	return b.get().Status == backupVerificationInProgress
+}
+
+// wordEntered counts a word accepted by the device for the pending verification
+func (b *backupVerification) wordEntered() {
+	b.lock.Lock()
+	if b.status.Status == backupVerificationInProgress {
+		b.status.WordsEntered++
+	}
+	b.lock.Unlock()
+}
+
+// resume records the firmware response of the pending verification.
+// If msg ends it, the device features are refreshed and the verification is returned.
+func (b *backupVerification) resume(gateway Gatewayer, msg wire.Message) *BackupVerification {
+	if isIntermediateMessage(msg) || !b.pending() {
+		return nil
+	}
+
+	status, message := backupVerificationResult(msg)
+
+	// the dry-run recovery may have changed needs_backup
+	var needsBackup *bool
+	if features, err := deviceFeatures(gateway); err != nil {
+		logger.WithError(err).Error("backup verification: failed to refresh the device features")
+	} else {
+		needsBackup = newBoolPtr(features.GetNeedsBackup())
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.status.Status != backupVerificationInProgress {
+		return nil
+	}
+
+	b.status.Status = status
+	b.status.Message = message
+	b.status.NeedsBackup = needsBackup
+
+	v := b.status
+	return &v
+}
+
+// fail records a daemon side error of the pending verification
+func (b *backupVerification) fail(message string) {
+	b.end(backupVerificationFailed, message)
+}
+
+// resumeFlow writes the verification if msg ends it
+func (b *backupVerification) resumeFlow(w http.ResponseWriter, r *http.Request, gateway Gatewayer, msg wire.Message) bool {
+	v := b.resume(gateway, msg)
+	if v == nil {
+		return false
+	}
+
+	writeBackupVerification(w, *v)
+	return true
+}
+
+func (b *backupVerification) failFlow(err error) {
+	b.fail(err.Error())
+}
+
+// cancelFlow records the answer of the device to a cancel request against the pending verification
+func (b *backupVerification) cancelFlow(gateway Gatewayer, msg wire.Message) {
+	b.resume(gateway, msg)
+}
+
+// interrupt records the pending verification as interrupted, it was replaced on the device by a new flow
+func (b *backupVerification) interrupt() {
+	b.end(backupVerificationInterrupted, "")
+}
+
+func (b *backupVerification) end(status, message string) {
+	b.lock.Lock()
+	if b.status.Status == backupVerificationInProgress {
+		b.status.Status = status
+		b.status.Message = message
+	}
+	b.lock.Unlock()
+}
+
+// backupVerificationResult returns the status and message of the firmware response which ends a verification
+func backupVerificationResult(msg wire.Message) (string, string) {
+	switch msg.Kind {
+	case uint16(messages.MessageType_MessageType_Success):
+		message, err := skyWallet.DecodeSuccessMsg(msg)
+		if err != nil {
+			return backupVerificationFailed, err.Error()
+		}
+		return backupVerificationMatches, message
+	case uint16(messages.MessageType_MessageType_Failure):
+		failure := &messages.Failure{}
+		if err := proto.Unmarshal(msg.Data, failure); err != nil {
+			return backupVerificationFailed, err.Error()
+		}
+
+		switch failure.GetCode() {
+		case messages.FailureType_Failure_DataError:
+			// the entered seed is invalid or does not match the seed of the device
+			return backupVerificationDoesNotMatch, failure.GetMessage()
+		case messages.FailureType_Failure_ActionCancelled, messages.FailureType_Failure_PinCancelled:
+			return backupVerificationCancelled, failure.GetMessage()
+		default:
+			return backupVerificationFailed, failure.GetMessage()
+		}
+	default:
+		return backupVerificationFailed, "received unexpected response message type: " + messages.MessageType(msg.Kind).String()
+	}
+}
+
+// writeBackupVerification writes a verification which ended. A verification which could not
+// tell if the backup matches is written as an error, with the verification as data.
+func writeBackupVerification(w http.ResponseWriter, v BackupVerification) {
+	switch v.Status {
+	case backupVerificationMatches, backupVerificationDoesNotMatch:
+		writeHTTPResponse(w, HTTPResponse{
+			Data: v,
+		})
+	default:
+		resp := NewHTTPErrorResponse(http.StatusConflict, v.Message)
+		resp.Data = v
+		writeHTTPResponse(w, resp)
+	}
+}
+
+// backupVerify starts a backup verification or returns the status of the last one.
+// A verification is a dry-run recovery: the user enters the words of the backup through
+// /api/v1/intermediate/word and the device checks they match its seed, without changing it.
+// URI: /api/v1/backup/verify
+// Method: GET, POST
+// Args: JSON Body for POST
+func backupVerify(gateway Gatewayer, backups *backupVerification, flows *deviceFlows) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			writeHTTPResponse(w, HTTPResponse{
+				Data: backups.get(),
+			})
+			return
+		case http.MethodPost:
+		default:
+			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if r.Header.Get("Content-Type") != ContentTypeJSON {
+			resp := NewHTTPErrorResponse(http.StatusUnsupportedMediaType, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		var req BackupVerifyRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			resp := NewHTTPErrorResponse(http.StatusBadRequest, err.Error())
+			writeHTTPResponse(w, resp)
+			return
+		}
+		defer r.Body.Close()
+
+		if req.WordCount != 12 && req.WordCount != 24 {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, "word_count must be 12 or 24")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		// starting the verification replaces any flow waiting for user input
+		flows.interrupt(backups)
+
+		// for integration tests
+		if autoPressEmulatorButtons {
+			err := gateway.SetAutoPressButton(true, skyWallet.ButtonRight)
+			if err != nil {
+				logger.Error("backupVerify failed: %s", err.Error())
+				resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+				writeHTTPResponse(w, resp)
+				return
+			}
+		}
+
+		backups.begin(req.WordCount)
+
+		var msg wire.Message
+		var err error
+		retCH := make(chan int)
+		errCH := make(chan int)
+		ctx := r.Context()
+
+		go func() {
+			msg, err = gateway.Recovery(req.WordCount, nil, true)
+			if err != nil {
+				errCH <- 1
+				return
+			}
+			retCH <- 1
+		}()
+
+		select {
+		case <-retCH:
+			if v := backups.resume(gateway, msg); v != nil {
+				writeBackupVerification(w, *v)
+			} else {
+				HandleFirmwareResponseMessages(w, msg)
+			}
+		case <-errCH:
+			backups.fail(err.Error())
+			logger.Errorf("backupVerify failed: %s", err.Error())
+			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+			writeHTTPResponse(w, resp)
+		case <-ctx.Done():
+			backups.interrupt()
+			disConnErr := gateway.Disconnect()
+			if disConnErr != nil {
+				resp := NewHTTPErrorResponse(http.StatusInternalServerError, disConnErr.Error())
+				writeHTTPResponse(w, resp)
+			} else {
+				resp := NewHTTPErrorResponse(499, "Client Closed Request")
+				writeHTTPResponse(w, resp)
+			}
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+	"github.com/stretchr/testify/require"
+)
+
+var wordRequestMessage = wire.Message{
+	Kind: uint16(messages.MessageType_MessageType_WordRequest),
+}
+
+func testDataErrorMessage(t *testing.T, msg string) wire.Message {
+	failure := messages.Failure{
+		Code:    messages.FailureType_Failure_DataError.Enum(),
+		Message: newStrPtr(msg),
+	}
+
+	data, err := failure.Marshal()
+	require.NoError(t, err)
+
+	return wire.Message{
+		Kind: uint16(messages.MessageType_MessageType_Failure),
+		Data: data,
+	}
+}
+
+func testNeedsBackupFeaturesMessage(t *testing.T, needsBackup bool) wire.Message {
+	features := messages.Features{
+		NeedsBackup: newBoolPtr(needsBackup),
+	}
+
+	data, err := features.Marshal()
+	require.NoError(t, err)
+
+	return wire.Message{
+		Kind: uint16(messages.MessageType_MessageType_Features),
+		Data: data,
+	}
+}
+
+func getBackupVerification(t *testing.T, handler http.Handler) BackupVerification {
+	rr := serveTestRequest(t, handler, http.MethodGet, "/backup/verify", "", nil)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	var rsp ReceivedHTTPResponse
+	err := json.NewDecoder(rr.Body).Decode(&rsp)
+	require.NoError(t, err)
+
+	var v BackupVerification
+	err = json.Unmarshal(rsp.Data, &v)
+	require.NoError(t, err)
+	return v
+}
+
+func TestBackupVerifyValidation(t *testing.T) {
+	cases := []struct {
+		name         string
+		method       string
+		contentType  string
+		httpBody     string
+		status       int
+		httpResponse HTTPResponse
+	}{
+		{
+			name:         "405",
+			method:       http.MethodPut,
+			status:       http.StatusMethodNotAllowed,
+			httpResponse: NewHTTPErrorResponse(http.StatusMethodNotAllowed, ""),
+		},
+
+		{
+			name:         "415 - Unsupported Media Type",
+			method:       http.MethodPost,
+			contentType:  ContentTypeForm,
+			status:       http.StatusUnsupportedMediaType,
+			httpResponse: NewHTTPErrorResponse(http.StatusUnsupportedMediaType, ""),
+		},
+
+		{
+			name:         "422 - invalid word count",
+			method:       http.MethodPost,
+			contentType:  ContentTypeJSON,
+			httpBody:     toJSON(t, BackupVerifyRequest{WordCount: 18}),
+			status:       http.StatusUnprocessableEntity,
+			httpResponse: newInvalidRequestResponse("word_count in body should be one of [12 24]"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+			handler := newServerMux(defaultMuxConfig(), gateway)
+
+			rr := serveTestRequest(t, handler, tc.method, "/backup/verify", tc.httpBody, map[string]string{
+				"Content-Type": tc.contentType,
+			})
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+
+			var rsp ReceivedHTTPResponse
+			err := json.NewDecoder(rr.Body).Decode(&rsp)
+			require.NoError(t, err)
+			require.Equal(t, tc.httpResponse.Error, rsp.Error)
+
+			require.Equal(t, BackupVerification{Status: backupVerificationNone}, getBackupVerification(t, handler))
+		})
+	}
+}
+
+func TestBackupVerify(t *testing.T) {
+	cases := []struct {
+		name         string
+		result       wire.Message
+		needsBackup  bool
+		status       int
+		errorMessage string
+		verification BackupVerification
+	}{
+		{
+			name:        "matches",
+			result:      testSuccessMessage(t, "The seed is valid and matches the one in the device"),
+			needsBackup: false,
+			status:      http.StatusOK,
+			verification: BackupVerification{
+				Status:       backupVerificationMatches,
+				WordCount:    12,
+				WordsEntered: 2,
+				Message:      "The seed is valid and matches the one in the device",
+				NeedsBackup:  newBoolPtr(false),
+			},
+		},
+
+		{
+			name:        "does not match",
+			result:      testDataErrorMessage(t, "The seed is valid but does not match the one in the device"),
+			needsBackup: true,
+			status:      http.StatusOK,
+			verification: BackupVerification{
+				Status:       backupVerificationDoesNotMatch,
+				WordCount:    12,
+				WordsEntered: 2,
+				Message:      "The seed is valid but does not match the one in the device",
+				NeedsBackup:  newBoolPtr(true),
+			},
+		},
+
+		{
+			name:         "cancelled",
+			result:       testFailureMessage(t, "Action cancelled by user"),
+			needsBackup:  true,
+			status:       http.StatusConflict,
+			errorMessage: "Action cancelled by user",
+			verification: BackupVerification{
+				Status:       backupVerificationCancelled,
+				WordCount:    12,
+				WordsEntered: 2,
+				Message:      "Action cancelled by user",
+				NeedsBackup:  newBoolPtr(true),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+			gateway.On("Recovery", uint32(12), (*bool)(nil), true).Return(wordRequestMessage, nil)
+			gateway.On("WordAck", "cloud").Return(wordRequestMessage, nil).Once()
+			gateway.On("WordAck", "flower").Return(tc.result, nil).Once()
+			gateway.On("GetFeatures").Return(testNeedsBackupFeaturesMessage(t, tc.needsBackup), nil)
+
+			handler := newServerMux(defaultMuxConfig(), gateway)
+
+			rr := serveTestRequest(t, handler, http.MethodPost, "/backup/verify", toJSON(t, BackupVerifyRequest{WordCount: 12}), map[string]string{
+				"Content-Type": ContentTypeJSON,
+			})
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+			require.JSONEq(t, `{"data":["WordRequest"],"intermediate":{"kind":"word","operation":"backup/verify"}}`, rr.Body.String())
+
+			require.Equal(t, BackupVerification{
+				Status:    backupVerificationInProgress,
+				WordCount: 12,
+			}, getBackupVerification(t, handler))
+
+			rr = serveTestRequest(t, handler, http.MethodPost, "/intermediate/word", toJSON(t, WordRequest{Word: "cloud"}), nil)
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+			require.JSONEq(t, `{"data":["WordRequest"],"intermediate":{"kind":"word","operation":"backup/verify"}}`, rr.Body.String())
+
+			require.Equal(t, BackupVerification{
+				Status:       backupVerificationInProgress,
+				WordCount:    12,
+				WordsEntered: 1,
+			}, getBackupVerification(t, handler))
+
+			rr = serveTestRequest(t, handler, http.MethodPost, "/intermediate/word", toJSON(t, WordRequest{Word: "flower"}), nil)
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+
+			var rsp ReceivedHTTPResponse
+			err := json.NewDecoder(rr.Body).Decode(&rsp)
+			require.NoError(t, err)
+
+			if tc.errorMessage != "" {
+				require.Equal(t, &HTTPError{Code: tc.status, Message: tc.errorMessage}, rsp.Error)
+			} else {
+				require.Nil(t, rsp.Error)
+			}
+
+			var v BackupVerification
+			err = json.Unmarshal(rsp.Data, &v)
+			require.NoError(t, err)
+			require.Equal(t, tc.verification, v)
+
+			require.Equal(t, tc.verification, getBackupVerification(t, handler))
+
+			gateway.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBackupVerifyInterrupted(t *testing.T) {
+	gateway := &MockGatewayer{}
+	gateway.On("Recovery", uint32(24), (*bool)(nil), true).Return(wordRequestMessage, nil)
+	gateway.On("GetFeatures").Return(testNeedsBackupFeaturesMessage(t, true), nil)
+
+	handler := newServerMux(defaultMuxConfig(), gateway)
+
+	rr := serveTestRequest(t, handler, http.MethodPost, "/backup/verify", toJSON(t, BackupVerifyRequest{WordCount: 24}), map[string]string{
+		"Content-Type": ContentTypeJSON,
+	})
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	// starting another flow replaces the verification on the device
+	rr = serveTestRequest(t, handler, http.MethodGet, "/features", "", nil)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	require.Equal(t, BackupVerification{
+		Status:    backupVerificationInterrupted,
+		WordCount: 24,
+	}, getBackupVerification(t, handler))
+
+	gateway.AssertExpectations(t)
+}
@@ -9,7 +9,7 @@ import (
 
 // URI: /api/v1/cancel
 // Method: PUT
-func cancel(gateway Gatewayer) http.HandlerFunc {
+func cancel(gateway Gatewayer, flows *deviceFlows) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPut {
 			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
@@ -36,6 +36,8 @@ func cancel(gateway Gatewayer) http.HandlerFunc {
 			return
 		}
 
+		flows.cancel(gateway, msg)
+
 		if msg.Kind == uint16(messages.MessageType_MessageType_Failure) {
 			failureMsg, err := skyWallet.DecodeFailMsg(msg)
 			if err != nil {
@@ -2,6 +2,7 @@ package api
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
@@ -15,13 +16,23 @@ type CheckMessageSignatureRequest struct {
 	Message   string `json:"message"`
 	Signature string `json:"signature"`
 	Address   string `json:"address"`
+	// Offline checks the signature in the daemon, without the device
+	Offline bool `json:"offline"`
+}
+
+// CheckArmoredMessageSignatureRequest is request data for /api/v1/check_message_signature/armored
+type CheckArmoredMessageSignatureRequest struct {
+	// Armored is a signed message in the armored text format
+	Armored string `json:"armored"`
+	// Offline checks the signature in the daemon, without the device
+	Offline bool `json:"offline"`
 }
 
 // URI: /api/v1/checkMessageSignature
 // Method: POST
 // Content-Type: application/json
 // Args: JSON Body
-func checkMessageSignature(gateway Gatewayer) http.HandlerFunc {
+func checkMessageSignature(gateway Gatewayer, flows *deviceFlows) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
@@ -43,72 +54,147 @@ func checkMessageSignature(gateway Gatewayer) http.HandlerFunc {
 		}
 		defer r.Body.Close()
 
-		if req.Address == "" {
-			resp := NewHTTPErrorResponse(http.StatusBadRequest, "address is required")
+		checkMessageSignatureRequest(w, r, gateway, flows, req)
+	}
+}
+
+// URI: /api/v1/check_message_signature/armored
+// Method: POST
+// Content-Type: application/json
+// Args: JSON Body
+func checkArmoredMessageSignature(gateway Gatewayer, flows *deviceFlows) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if r.Header.Get("Content-Type") != ContentTypeJSON {
+			resp := NewHTTPErrorResponse(http.StatusUnsupportedMediaType, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		var req CheckArmoredMessageSignatureRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			resp := NewHTTPErrorResponse(http.StatusBadRequest, err.Error())
+			writeHTTPResponse(w, resp)
+			return
+		}
+		defer r.Body.Close()
+
+		if req.Armored == "" {
+			resp := NewHTTPErrorResponse(http.StatusBadRequest, "armored is required")
 			writeHTTPResponse(w, resp)
 			return
 		}
 
-		_, err := cipher.DecodeBase58Address(req.Address)
+		signed, err := ParseArmoredMessage(req.Armored)
 		if err != nil {
-			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, err.Error())
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, fmt.Sprintf("invalid armored message: %v", err))
 			writeHTTPResponse(w, resp)
 			return
 		}
 
-		if req.Signature == "" {
-			resp := NewHTTPErrorResponse(http.StatusBadRequest, "signature is required")
+		checkMessageSignatureRequest(w, r, gateway, flows, CheckMessageSignatureRequest{
+			Message:   signed.Message,
+			Signature: signed.Signature,
+			Address:   signed.Address,
+			Offline:   req.Offline,
+		})
+	}
+}
+
+// checkMessageSignatureRequest checks the signature of the request, in the daemon if it is offline or else on the device
+func checkMessageSignatureRequest(w http.ResponseWriter, r *http.Request, gateway Gatewayer, flows *deviceFlows, req CheckMessageSignatureRequest) {
+	if req.Address == "" {
+		resp := NewHTTPErrorResponse(http.StatusBadRequest, "address is required")
+		writeHTTPResponse(w, resp)
+		return
+	}
+
+	address, err := cipher.DecodeBase58Address(req.Address)
+	if err != nil {
+		resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, err.Error())
+		writeHTTPResponse(w, resp)
+		return
+	}
+
+	if req.Signature == "" {
+		resp := NewHTTPErrorResponse(http.StatusBadRequest, "signature is required")
+		writeHTTPResponse(w, resp)
+		return
+	}
+
+	if req.Message == "" {
+		resp := NewHTTPErrorResponse(http.StatusBadRequest, "message is required")
+		writeHTTPResponse(w, resp)
+		return
+	}
+
+	if req.Offline {
+		recovered, err := recoverAddress(req.Message, req.Signature)
+		if err != nil {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, fmt.Sprintf("invalid signature: %v", err))
 			writeHTTPResponse(w, resp)
 			return
 		}
 
-		if req.Message == "" {
-			resp := NewHTTPErrorResponse(http.StatusBadRequest, "message is required")
+		if recovered != address {
+			resp := NewHTTPErrorResponse(http.StatusConflict, "address does not match signature")
 			writeHTTPResponse(w, resp)
 			return
 		}
 
-		// for integration tests
-		if autoPressEmulatorButtons {
-			err := gateway.SetAutoPressButton(true, skyWallet.ButtonRight)
-			if err != nil {
-				logger.Error("checkMessageSignature failed: %s", err.Error())
-				resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
-				writeHTTPResponse(w, resp)
-				return
-			}
+		writeHTTPResponse(w, HTTPResponse{
+			Data: []string{recovered.String()},
+		})
+		return
+	}
+
+	flows.interrupt()
+
+	// for integration tests
+	if autoPressEmulatorButtons {
+		err := gateway.SetAutoPressButton(true, skyWallet.ButtonRight)
+		if err != nil {
+			logger.Error("checkMessageSignature failed: %s", err.Error())
+			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+			writeHTTPResponse(w, resp)
+			return
 		}
+	}
 
-		var msg wire.Message
-		retCH := make(chan int)
-		errCH := make(chan int)
-		ctx := r.Context()
-
-		go func() {
-			msg, err = gateway.CheckMessageSignature(req.Message, req.Signature, req.Address)
-			if err != nil {
-				errCH <- 1
-				return
-			}
-			retCH <- 1
-		}()
-
-		select {
-		case <-retCH:
-			HandleFirmwareResponseMessages(w, msg)
-		case <-errCH:
-			logger.Errorf("checkMessageSignature failed: %s", err.Error())
+	var msg wire.Message
+	retCH := make(chan int)
+	errCH := make(chan int)
+	ctx := r.Context()
+
+	go func() {
+		msg, err = gateway.CheckMessageSignature(req.Message, req.Signature, req.Address)
+		if err != nil {
+			errCH <- 1
+			return
+		}
+		retCH <- 1
+	}()
+
+	select {
+	case <-retCH:
+		HandleFirmwareResponseMessages(w, msg)
+	case <-errCH:
+		logger.Errorf("checkMessageSignature failed: %s", err.Error())
+		resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+		writeHTTPResponse(w, resp)
+	case <-ctx.Done():
+		disConnErr := gateway.Disconnect()
+		if disConnErr != nil {
 			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
 			writeHTTPResponse(w, resp)
-		case <-ctx.Done():
-			disConnErr := gateway.Disconnect()
-			if disConnErr != nil {
-				resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
-				writeHTTPResponse(w, resp)
-			} else {
-				resp := NewHTTPErrorResponse(499, "Client Closed Request")
-				writeHTTPResponse(w, resp)
-			}
+		} else {
+			resp := NewHTTPErrorResponse(499, "Client Closed Request")
+			writeHTTPResponse(w, resp)
 		}
 	}
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
 	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -137,7 +138,51 @@ func TestCheckMessageSignature(t *testing.T) {
 				Kind: uint16(messages.MessageType_MessageType_Failure),
 				Data: failureMsgBytes,
 			},
-			httpResponse: NewHTTPErrorResponse(http.StatusConflict, "failure msg"),
+			httpResponse: newTypedHTTPErrorResponse(http.StatusConflict, ErrorTypeNotInitialized, "failure msg"),
+		},
+
+		{
+			name:        "200 - offline",
+			method:      http.MethodPost,
+			status:      http.StatusOK,
+			contentType: ContentTypeJSON,
+			httpBody: toJSON(t, &CheckMessageSignatureRequest{
+				Address:   testMessageAddress,
+				Signature: testMessageSignature,
+				Message:   testMessage,
+				Offline:   true,
+			}),
+			httpResponse: HTTPResponse{
+				Data: []string{testMessageAddress},
+			},
+		},
+
+		{
+			name:        "409 - offline address mismatch",
+			method:      http.MethodPost,
+			status:      http.StatusConflict,
+			contentType: ContentTypeJSON,
+			httpBody: toJSON(t, &CheckMessageSignatureRequest{
+				Address:   "u37EnnuQ4g58sWpd5Ns3FWGPwSgEuQGFBd",
+				Signature: testMessageSignature,
+				Message:   testMessage,
+				Offline:   true,
+			}),
+			httpResponse: NewHTTPErrorResponse(http.StatusConflict, "address does not match signature"),
+		},
+
+		{
+			name:        "422 - offline invalid signature",
+			method:      http.MethodPost,
+			status:      http.StatusUnprocessableEntity,
+			contentType: ContentTypeJSON,
+			httpBody: toJSON(t, &CheckMessageSignatureRequest{
+				Address:   testMessageAddress,
+				Signature: "GvKS4S3CA2YTpEPFA47yFdC5CP3y3qB18jwiX1URXqWQTvMjokd3A4upPz4wyeAyKJEtRdRDGUvUgoGASpsTTUeMn",
+				Message:   testMessage,
+				Offline:   true,
+			}),
+			httpResponse: NewHTTPErrorResponse(http.StatusUnprocessableEntity, "invalid signature: Invalid signature"),
 		},
 	}
 
@@ -179,12 +224,96 @@ func TestCheckMessageSignature(t *testing.T) {
 				require.Nil(t, tc.httpResponse.Data)
 			} else {
 				require.NotNil(t, tc.httpResponse.Data)
+				require.JSONEq(t, toJSON(t, tc.httpResponse.Data), string(rsp.Data))
+			}
+
+			if body.Offline {
+				gateway.AssertNotCalled(t, "CheckMessageSignature", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
 
-				var resp string
-				err = json.Unmarshal(rsp.Data, &resp)
-				require.NoError(t, err)
+func TestCheckArmoredMessageSignature(t *testing.T) {
+	armored := SignedMessage{
+		Message:   testMessage,
+		Address:   testMessageAddress,
+		Signature: testMessageSignature,
+		Version:   "0.1.0",
+	}.Armor()
 
-				require.Equal(t, tc.httpResponse.Data.(string), resp)
+	cases := []struct {
+		name         string
+		status       int
+		httpBody     string
+		checked      bool
+		httpResponse HTTPResponse
+	}{
+		{
+			name:   "200 - offline",
+			status: http.StatusOK,
+			httpBody: toJSON(t, &CheckArmoredMessageSignatureRequest{
+				Armored: armored,
+				Offline: true,
+			}),
+			httpResponse: HTTPResponse{
+				Data: []string{testMessageAddress},
+			},
+		},
+
+		{
+			name:   "200 - device",
+			status: http.StatusOK,
+			httpBody: toJSON(t, &CheckArmoredMessageSignatureRequest{
+				Armored: armored,
+			}),
+			checked: true,
+			httpResponse: HTTPResponse{
+				Data: []string{testMessageAddress},
+			},
+		},
+
+		{
+			name:         "422 - missing armored",
+			status:       http.StatusUnprocessableEntity,
+			httpBody:     `{"offline": true}`,
+			httpResponse: newInvalidRequestResponse(".armored in body is required"),
+		},
+
+		{
+			name:   "422 - invalid armored message",
+			status: http.StatusUnprocessableEntity,
+			httpBody: toJSON(t, &CheckArmoredMessageSignatureRequest{
+				Armored: "foo",
+			}),
+			httpResponse: NewHTTPErrorResponse(http.StatusUnprocessableEntity, "invalid armored message: missing begin line"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+			gateway.On("CheckMessageSignature", testMessage, testMessageSignature, testMessageAddress).Return(testSuccessMessage(t, testMessageAddress), nil)
+
+			handler := newServerMux(defaultMuxConfig(), gateway)
+			rr := serveTestRequest(t, handler, http.MethodPost, "/check_message_signature/armored", tc.httpBody, map[string]string{
+				"Content-Type": ContentTypeJSON,
+			})
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+
+			var rsp ReceivedHTTPResponse
+			err := json.NewDecoder(rr.Body).Decode(&rsp)
+			require.NoError(t, err)
+			require.Equal(t, tc.httpResponse.Error, rsp.Error)
+
+			if tc.httpResponse.Data != nil {
+				require.JSONEq(t, toJSON(t, tc.httpResponse.Data), string(rsp.Data))
+			}
+
+			if tc.checked {
+				gateway.AssertNumberOfCalls(t, "CheckMessageSignature", 1)
+			} else {
+				gateway.AssertNotCalled(t, "CheckMessageSignature", mock.Anything, mock.Anything, mock.Anything)
 			}
 		})
 	}
@@ -71,7 +71,7 @@ func TestConfigurePinCode(t *testing.T) {
 				Kind: uint16(messages.MessageType_MessageType_Failure),
 				Data: failureMsgBytes,
 			},
-			httpResponse: NewHTTPErrorResponse(http.StatusConflict, "failure msg"),
+			httpResponse: newTypedHTTPErrorResponse(http.StatusConflict, ErrorTypeNotInitialized, "failure msg"),
 		},
 
 		{
@@ -85,7 +85,7 @@ func TestConfigurePinCode(t *testing.T) {
 				Kind: uint16(messages.MessageType_MessageType_Failure),
 				Data: failureMsgBytes,
 			},
-			httpResponse: NewHTTPErrorResponse(http.StatusConflict, "failure msg"),
+			httpResponse: newTypedHTTPErrorResponse(http.StatusConflict, ErrorTypeNotInitialized, "failure msg"),
 		},
 
 		{
@@ -0,0 +1,166 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	skyWallet "github.com/SkycoinProject/hardware-wallet-go/src/skywallet"
+)
+
+// entropy downloads entropy generated by the device. The device asks the user to confirm
+// the download when its firmware requires it, the request waits until the user answers.
+// The entropy is written in a private temporary directory and then streamed to the client.
+// URI: /api/v1/entropy/raw, /api/v1/entropy/mixed
+// Method: GET
+// Args:
+//  bytes: number of entropy bytes to download [required]
+func entropy(gateway Gatewayer, getEntropyMsgBuilder func(entropyBytes uint32) ([][64]byte, error), maxEntropyBytes uint32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		entropyBytes, ok := entropyBytesArg(w, r, maxEntropyBytes)
+		if !ok {
+			return
+		}
+
+		downloadEntropy(w, r, gateway, getEntropyMsgBuilder, entropyBytes, func(outFile string) {
+			writeEntropy(w, outFile, entropyBytes)
+		})
+	}
+}
+
+// entropyBytesArg returns the bytes argument of an entropy request.
+// If it is not valid, the error response is written and false is returned.
+func entropyBytesArg(w http.ResponseWriter, r *http.Request, maxEntropyBytes uint32) (uint32, bool) {
+	bytesStr := r.FormValue("bytes")
+	if bytesStr == "" {
+		resp := NewHTTPErrorResponse(http.StatusBadRequest, "bytes is required")
+		writeHTTPResponse(w, resp)
+		return 0, false
+	}
+
+	entropyBytes, err := strconv.ParseUint(bytesStr, 10, 32)
+	if err != nil {
+		resp := NewHTTPErrorResponse(http.StatusBadRequest, "invalid bytes value")
+		writeHTTPResponse(w, resp)
+		return 0, false
+	}
+
+	if entropyBytes == 0 {
+		resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, "bytes cannot be 0")
+		writeHTTPResponse(w, resp)
+		return 0, false
+	}
+
+	if entropyBytes > uint64(maxEntropyBytes) {
+		resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, fmt.Sprintf("bytes cannot be more than %d", maxEntropyBytes))
+		writeHTTPResponse(w, resp)
+		return 0, false
+	}
+
+	return uint32(entropyBytes), true
+}
+
+// downloadEntropy asks the device for entropyBytes bytes of entropy and calls handle
+// with the file they are saved in, which is removed once handle returns.
+// Errors are written to the response.
+func downloadEntropy(w http.ResponseWriter, r *http.Request, gateway Gatewayer, getEntropyMsgBuilder func(entropyBytes uint32) ([][64]byte, error), entropyBytes uint32, handle func(outFile string)) {
+	// for integration tests
+	if autoPressEmulatorButtons {
+		err := gateway.SetAutoPressButton(true, skyWallet.ButtonRight)
+		if err != nil {
+			logger.Error("entropy failed: %s", err.Error())
+			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+			writeHTTPResponse(w, resp)
+			return
+		}
+	}
+
+	// the directory is only readable by the daemon user, the file is created by the device
+	dir, err := ioutil.TempDir("", "entropy")
+	if err != nil {
+		logger.Errorf("entropy failed: %s", err.Error())
+		resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+		writeHTTPResponse(w, resp)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	outFile := filepath.Join(dir, "entropy")
+
+	retCH := make(chan int)
+	errCH := make(chan int)
+	ctx := r.Context()
+
+	go func() {
+		err = gateway.SaveDeviceEntropyInFile(outFile, entropyBytes, getEntropyMsgBuilder)
+		if err != nil {
+			errCH <- 1
+			return
+		}
+		retCH <- 1
+	}()
+
+	select {
+	case <-retCH:
+		info, err := os.Stat(outFile)
+		if err != nil {
+			logger.Errorf("entropy failed: %s", err.Error())
+			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if info.Size() != int64(entropyBytes) {
+			err := fmt.Errorf("device returned %d entropy bytes, expected %d", info.Size(), entropyBytes)
+			logger.Errorf("entropy failed: %s", err.Error())
+			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		handle(outFile)
+	case <-errCH:
+		logger.Errorf("entropy failed: %s", err.Error())
+		resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+		writeHTTPResponse(w, resp)
+	case <-ctx.Done():
+		disConnErr := gateway.Disconnect()
+		if disConnErr != nil {
+			resp := NewHTTPErrorResponse(http.StatusInternalServerError, disConnErr.Error())
+			writeHTTPResponse(w, resp)
+		} else {
+			resp := NewHTTPErrorResponse(499, "Client Closed Request")
+			writeHTTPResponse(w, resp)
+		}
+	}
+}
+
+// writeEntropy streams the entropy saved by the device as application/octet-stream
+func writeEntropy(w http.ResponseWriter, outFile string, entropyBytes uint32) {
+	f, err := os.Open(outFile)
+	if err != nil {
+		logger.Errorf("entropy failed: %s", err.Error())
+		resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+		writeHTTPResponse(w, resp)
+		return
+	}
+	defer f.Close()
+
+	w.Header().Set("Content-Type", ContentTypeOctetStream)
+	w.Header().Set("Content-Length", strconv.FormatUint(uint64(entropyBytes), 10))
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := io.Copy(w, f); err != nil {
+		logger.Errorf("entropy failed: writing the response: %s", err.Error())
+	}
+}
@@ -0,0 +1,227 @@
+package api
+
+import (
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+
+	skyWallet "github.com/SkycoinProject/hardware-wallet-go/src/skywallet"
+)
+
+const (
+	// entropySignificanceLevel is the minimum p-value of a passed statistical test, as recommended by NIST SP 800-22
+	entropySignificanceLevel = 0.01
+	// minEntropyCompressionRatio is the minimum compressed to original size ratio of passed entropy.
+	// Random data can't be compressed, deflate only adds its framing to it.
+	minEntropyCompressionRatio = 0.99
+	// minEntropyReportBytes is the minimum sample size of a report, so that each
+	// byte value is expected at least 5 times by the chi-square test
+	minEntropyReportBytes = 5 * 256
+)
+
+// Names of the statistical tests of an entropy report
+const (
+	entropyTestMonobit     = "monobit"
+	entropyTestRuns        = "runs"
+	entropyTestChiSquare   = "chi_square"
+	entropyTestCompression = "compression"
+)
+
+// EntropyTest is the result of a statistical test of an entropy sample.
+// Tests with a p-value pass when it is at least the threshold,
+// the compression test passes when its statistic, the compression ratio, is at least the threshold.
+type EntropyTest struct {
+	Name      string   `json:"name"`
+	Statistic float64  `json:"statistic"`
+	PValue    *float64 `json:"p_value,omitempty"`
+	Threshold float64  `json:"threshold"`
+	Passed    bool     `json:"passed"`
+}
+
+// EntropyReport is data returned by GET /api/v1/entropy/report
+type EntropyReport struct {
+	// Type is the entropy type, raw or mixed
+	Type   string        `json:"type"`
+	Bytes  int           `json:"bytes"`
+	Passed bool          `json:"passed"`
+	Tests  []EntropyTest `json:"tests"`
+}
+
+// newEntropyReport runs the statistical tests on an entropy sample
+func newEntropyReport(entropyType string, data []byte) EntropyReport {
+	report := EntropyReport{
+		Type:  entropyType,
+		Bytes: len(data),
+		Tests: []EntropyTest{
+			monobitTest(data),
+			runsTest(data),
+			chiSquareTest(data),
+			compressionTest(data),
+		},
+	}
+
+	report.Passed = true
+	for _, t := range report.Tests {
+		report.Passed = report.Passed && t.Passed
+	}
+
+	return report
+}
+
+func pValueTest(name string, statistic, pValue float64) EntropyTest {
+	return EntropyTest{
+		Name:      name,
+		Statistic: statistic,
+		PValue:    &pValue,
+		Threshold: entropySignificanceLevel,
+		Passed:    pValue >= entropySignificanceLevel,
+	}
+}
+
+func bitAt(data []byte, i int) byte {
+	return (data[i/8] >> uint(7-i%8)) & 1
+}
+
+func countOnes(data []byte) int {
+	ones := 0
+	for _, b := range data {
+		for ; b != 0; b &= b - 1 {
+			ones++
+		}
+	}
+	return ones
+}
+
+// monobitTest checks the proportion of ones and zeros of the sample is close to 1/2.
+// It is the frequency test of NIST SP 800-22, the statistic is |S_n| / sqrt(n).
+func monobitTest(data []byte) EntropyTest {
+	n := float64(8 * len(data))
+	sum := float64(2*countOnes(data)) - n
+	sObs := math.Abs(sum) / math.Sqrt(n)
+
+	return pValueTest(entropyTestMonobit, sObs, math.Erfc(sObs/math.Sqrt2))
+}
+
+// runsTest checks the number of runs of identical bits of the sample is the expected one.
+// It is the runs test of NIST SP 800-22, the statistic is the number of runs.
+func runsTest(data []byte) EntropyTest {
+	n := 8 * len(data)
+	pi := float64(countOnes(data)) / float64(n)
+
+	runs := 1
+	for i := 1; i < n; i++ {
+		if bitAt(data, i) != bitAt(data, i-1) {
+			runs++
+		}
+	}
+
+	// the test is not applicable if the monobit test fails, the sample fails it
+	if math.Abs(pi-0.5) >= 2/math.Sqrt(float64(n)) {
+		return pValueTest(entropyTestRuns, float64(runs), 0)
+	}
+
+	expected := 2 * float64(n) * pi * (1 - pi)
+	pValue := math.Erfc(math.Abs(float64(runs)-expected) / (2 * math.Sqrt(2*float64(n)) * pi * (1 - pi)))
+
+	return pValueTest(entropyTestRuns, float64(runs), pValue)
+}
+
+// chiSquareTest checks the byte values of the sample are uniformly distributed.
+// The p-value of the statistic, with 255 degrees of freedom, is computed
+// with the Wilson-Hilferty normal approximation.
+func chiSquareTest(data []byte) EntropyTest {
+	var counts [256]int
+	for _, b := range data {
+		counts[b]++
+	}
+
+	expected := float64(len(data)) / 256
+	chiSquare := 0.0
+	for _, c := range counts {
+		d := float64(c) - expected
+		chiSquare += d * d / expected
+	}
+
+	k := 255.0
+	z := (math.Cbrt(chiSquare/k) - (1 - 2/(9*k))) / math.Sqrt(2/(9*k))
+	pValue := math.Erfc(z/math.Sqrt2) / 2
+
+	return pValueTest(entropyTestChiSquare, chiSquare, pValue)
+}
+
+// compressionTest checks the sample can't be compressed, the statistic is the compression ratio
+func compressionTest(data []byte) EntropyTest {
+	var buf bytes.Buffer
+	// the level is valid and writing to a bytes.Buffer does not fail
+	fw, _ := flate.NewWriter(&buf, flate.BestCompression) // nolint: errcheck
+	fw.Write(data)                                        // nolint: errcheck
+	fw.Close()                                            // nolint: errcheck
+
+	ratio := float64(buf.Len()) / float64(len(data))
+
+	return EntropyTest{
+		Name:      entropyTestCompression,
+		Statistic: ratio,
+		Threshold: minEntropyCompressionRatio,
+		Passed:    ratio >= minEntropyCompressionRatio,
+	}
+}
+
+// entropyReport downloads entropy generated by the device and returns a report of statistical tests run on it:
+// monobit, runs, chi-square byte distribution and compression ratio.
+// URI: /api/v1/entropy/report
+// Method: GET
+// Args:
+//  bytes: number of entropy bytes to test [required]
+//  type: "raw" or "mixed", defaults to "raw" [optional]
+func entropyReport(gateway Gatewayer, maxEntropyBytes uint32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		entropyType := r.FormValue("type")
+		var getEntropyMsgBuilder func(entropyBytes uint32) ([][64]byte, error)
+		switch entropyType {
+		case "", "raw":
+			entropyType = "raw"
+			getEntropyMsgBuilder = skyWallet.MessageDeviceGetRawEntropy
+		case "mixed":
+			getEntropyMsgBuilder = skyWallet.MessageDeviceGetMixedEntropy
+		default:
+			resp := NewHTTPErrorResponse(http.StatusBadRequest, "type must be raw or mixed")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		entropyBytes, ok := entropyBytesArg(w, r, maxEntropyBytes)
+		if !ok {
+			return
+		}
+
+		if entropyBytes < minEntropyReportBytes {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, fmt.Sprintf("bytes cannot be less than %d", minEntropyReportBytes))
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		downloadEntropy(w, r, gateway, getEntropyMsgBuilder, entropyBytes, func(outFile string) {
+			data, err := ioutil.ReadFile(outFile)
+			if err != nil {
+				logger.Errorf("entropyReport failed: %s", err.Error())
+				resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+				writeHTTPResponse(w, resp)
+				return
+			}
+
+			writeHTTPResponse(w, HTTPResponse{
+				Data: newEntropyReport(entropyType, data),
+			})
+		})
+	}
+}
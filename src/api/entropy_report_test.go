@@ -0,0 +1,209 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	skyWallet "github.com/SkycoinProject/hardware-wallet-go/src/skywallet"
+)
+
+func testRandomEntropy(n int) []byte {
+	data := make([]byte, n)
+	rand.New(rand.NewSource(1)).Read(data) // nolint: errcheck
+	return data
+}
+
+func TestNewEntropyReport(t *testing.T) {
+	cases := []struct {
+		name   string
+		data   []byte
+		passed map[string]bool
+	}{
+		{
+			name: "random",
+			data: testRandomEntropy(4096),
+			passed: map[string]bool{
+				entropyTestMonobit:     true,
+				entropyTestRuns:        true,
+				entropyTestChiSquare:   true,
+				entropyTestCompression: true,
+			},
+		},
+
+		{
+			name: "all ones",
+			data: bytes.Repeat([]byte{0xff}, 4096),
+			passed: map[string]bool{
+				entropyTestMonobit:     false,
+				entropyTestRuns:        false,
+				entropyTestChiSquare:   false,
+				entropyTestCompression: false,
+			},
+		},
+
+		{
+			name: "balanced bits, repeated byte",
+			data: bytes.Repeat([]byte{0x0f}, 4096),
+			passed: map[string]bool{
+				entropyTestMonobit:     true,
+				entropyTestRuns:        false,
+				entropyTestChiSquare:   false,
+				entropyTestCompression: false,
+			},
+		},
+
+		{
+			name: "balanced bits and runs, few byte values",
+			data: bytes.Repeat([]byte{0x4b, 0x2d, 0x96, 0xd2}, 1024),
+			passed: map[string]bool{
+				entropyTestMonobit:     true,
+				entropyTestChiSquare:   false,
+				entropyTestCompression: false,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			report := newEntropyReport("raw", tc.data)
+			require.Equal(t, "raw", report.Type)
+			require.Equal(t, len(tc.data), report.Bytes)
+			require.Len(t, report.Tests, 4)
+
+			passed := true
+			for _, test := range report.Tests {
+				passed = passed && test.Passed
+				if expected, ok := tc.passed[test.Name]; ok {
+					require.Equal(t, expected, test.Passed, test.Name)
+				}
+			}
+			require.Equal(t, passed, report.Passed)
+		})
+	}
+}
+
+func TestMonobitTest(t *testing.T) {
+	// 0x0f has as many ones as zeros
+	test := monobitTest(bytes.Repeat([]byte{0x0f}, 16))
+	require.Equal(t, 0.0, test.Statistic)
+	require.Equal(t, 1.0, *test.PValue)
+
+	// 129 ones and 127 zeros, s_obs = 2 / sqrt(256)
+	data := bytes.Repeat([]byte{0x0f}, 32)
+	data[0] = 0x1f
+	test = monobitTest(data)
+	require.Equal(t, 0.125, test.Statistic)
+	require.InDelta(t, 0.900523, *test.PValue, 1e-6)
+}
+
+func TestEntropyReport(t *testing.T) {
+	entropyData := testRandomEntropy(2048)
+
+	cases := []struct {
+		name         string
+		method       string
+		query        string
+		status       int
+		entropyBytes uint32
+		builder      func(uint32) ([][64]byte, error)
+		entropyType  string
+		httpResponse HTTPResponse
+	}{
+		{
+			name:         "405",
+			method:       http.MethodPost,
+			status:       http.StatusMethodNotAllowed,
+			httpResponse: NewHTTPErrorResponse(http.StatusMethodNotAllowed, ""),
+		},
+
+		{
+			name:         "400 - invalid type",
+			method:       http.MethodGet,
+			query:        "?bytes=2048&type=foo",
+			status:       http.StatusBadRequest,
+			httpResponse: NewHTTPErrorResponse(http.StatusBadRequest, "type must be raw or mixed"),
+		},
+
+		{
+			name:         "400 - no bytes",
+			method:       http.MethodGet,
+			status:       http.StatusBadRequest,
+			httpResponse: NewHTTPErrorResponse(http.StatusBadRequest, "bytes is required"),
+		},
+
+		{
+			name:         "422 - too few bytes",
+			method:       http.MethodGet,
+			query:        "?bytes=1279",
+			status:       http.StatusUnprocessableEntity,
+			httpResponse: NewHTTPErrorResponse(http.StatusUnprocessableEntity, "bytes cannot be less than 1280"),
+		},
+
+		{
+			name:         "200 - raw by default",
+			method:       http.MethodGet,
+			query:        "?bytes=2048",
+			status:       http.StatusOK,
+			entropyBytes: 2048,
+			builder:      skyWallet.MessageDeviceGetRawEntropy,
+			entropyType:  "raw",
+		},
+
+		{
+			name:         "200 - mixed",
+			method:       http.MethodGet,
+			query:        "?bytes=2048&type=mixed",
+			status:       http.StatusOK,
+			entropyBytes: 2048,
+			builder:      skyWallet.MessageDeviceGetMixedEntropy,
+			entropyType:  "mixed",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+
+			if tc.builder != nil {
+				gateway.On("SaveDeviceEntropyInFile", mock.Anything, tc.entropyBytes, mock.Anything).Run(func(args mock.Arguments) {
+					expected, err := tc.builder(tc.entropyBytes)
+					require.NoError(t, err)
+					builder := args.Get(2).(func(uint32) ([][64]byte, error))
+					chunks, err := builder(tc.entropyBytes)
+					require.NoError(t, err)
+					require.Equal(t, expected, chunks)
+
+					err = ioutil.WriteFile(args.String(0), entropyData, 0600)
+					require.NoError(t, err)
+				}).Return(nil)
+			}
+
+			handler := newServerMux(defaultMuxConfig(), gateway)
+
+			rr := serveTestRequest(t, handler, tc.method, "/entropy/report"+tc.query, "", nil)
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+
+			var rsp ReceivedHTTPResponse
+			err := json.NewDecoder(rr.Body).Decode(&rsp)
+			require.NoError(t, err)
+			require.Equal(t, tc.httpResponse.Error, rsp.Error)
+
+			if tc.status == http.StatusOK {
+				var report EntropyReport
+				err = json.Unmarshal(rsp.Data, &report)
+				require.NoError(t, err)
+				require.Equal(t, newEntropyReport(tc.entropyType, entropyData), report)
+				require.True(t, report.Passed)
+			}
+
+			gateway.AssertExpectations(t)
+		})
+	}
+}
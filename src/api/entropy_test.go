@@ -0,0 +1,166 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	skyWallet "github.com/SkycoinProject/hardware-wallet-go/src/skywallet"
+)
+
+func TestEntropy(t *testing.T) {
+	entropyData := bytes.Repeat([]byte{0xde, 0xad, 0xbe, 0xef}, 256)
+
+	cases := []struct {
+		name         string
+		method       string
+		endpoint     string
+		query        string
+		status       int
+		entropyBytes uint32
+		builder      func(uint32) ([][64]byte, error)
+		written      []byte
+		gatewayErr   error
+		httpResponse HTTPResponse
+	}{
+		{
+			name:         "405",
+			method:       http.MethodPost,
+			endpoint:     "/entropy/raw",
+			status:       http.StatusMethodNotAllowed,
+			httpResponse: NewHTTPErrorResponse(http.StatusMethodNotAllowed, ""),
+		},
+
+		{
+			name:         "400 - no bytes",
+			method:       http.MethodGet,
+			endpoint:     "/entropy/raw",
+			status:       http.StatusBadRequest,
+			httpResponse: NewHTTPErrorResponse(http.StatusBadRequest, "bytes is required"),
+		},
+
+		{
+			name:         "400 - invalid bytes",
+			method:       http.MethodGet,
+			endpoint:     "/entropy/raw",
+			query:        "?bytes=-1",
+			status:       http.StatusBadRequest,
+			httpResponse: NewHTTPErrorResponse(http.StatusBadRequest, "invalid bytes value"),
+		},
+
+		{
+			name:         "422 - zero bytes",
+			method:       http.MethodGet,
+			endpoint:     "/entropy/mixed",
+			query:        "?bytes=0",
+			status:       http.StatusUnprocessableEntity,
+			httpResponse: NewHTTPErrorResponse(http.StatusUnprocessableEntity, "bytes cannot be 0"),
+		},
+
+		{
+			name:         "422 - above limit",
+			method:       http.MethodGet,
+			endpoint:     "/entropy/mixed",
+			query:        "?bytes=10485761",
+			status:       http.StatusUnprocessableEntity,
+			httpResponse: NewHTTPErrorResponse(http.StatusUnprocessableEntity, "bytes cannot be more than 10485760"),
+		},
+
+		{
+			name:         "500 - device error",
+			method:       http.MethodGet,
+			endpoint:     "/entropy/raw",
+			query:        "?bytes=1024",
+			status:       http.StatusInternalServerError,
+			entropyBytes: 1024,
+			builder:      skyWallet.MessageDeviceGetRawEntropy,
+			gatewayErr:   errors.New("Action cancelled by user"),
+			httpResponse: NewHTTPErrorResponse(http.StatusInternalServerError, "Action cancelled by user"),
+		},
+
+		{
+			name:         "500 - short entropy",
+			method:       http.MethodGet,
+			endpoint:     "/entropy/raw",
+			query:        "?bytes=1024",
+			status:       http.StatusInternalServerError,
+			entropyBytes: 1024,
+			builder:      skyWallet.MessageDeviceGetRawEntropy,
+			written:      entropyData[:512],
+			httpResponse: NewHTTPErrorResponse(http.StatusInternalServerError, "device returned 512 entropy bytes, expected 1024"),
+		},
+
+		{
+			name:         "200 - raw",
+			method:       http.MethodGet,
+			endpoint:     "/entropy/raw",
+			query:        "?bytes=1024",
+			status:       http.StatusOK,
+			entropyBytes: 1024,
+			builder:      skyWallet.MessageDeviceGetRawEntropy,
+			written:      entropyData,
+		},
+
+		{
+			name:         "200 - mixed",
+			method:       http.MethodGet,
+			endpoint:     "/entropy/mixed",
+			query:        "?bytes=1024",
+			status:       http.StatusOK,
+			entropyBytes: 1024,
+			builder:      skyWallet.MessageDeviceGetMixedEntropy,
+			written:      entropyData,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+
+			if tc.builder != nil {
+				gateway.On("SaveDeviceEntropyInFile", mock.Anything, tc.entropyBytes, mock.Anything).Run(func(args mock.Arguments) {
+					// the request for the endpoint entropy type is sent to the device
+					expected, err := tc.builder(tc.entropyBytes)
+					require.NoError(t, err)
+					builder := args.Get(2).(func(uint32) ([][64]byte, error))
+					chunks, err := builder(tc.entropyBytes)
+					require.NoError(t, err)
+					require.Equal(t, expected, chunks)
+
+					if tc.written != nil {
+						err = ioutil.WriteFile(args.String(0), tc.written, 0600)
+						require.NoError(t, err)
+					}
+				}).Return(tc.gatewayErr)
+			}
+
+			handler := newServerMux(defaultMuxConfig(), gateway)
+
+			rr := serveTestRequest(t, handler, tc.method, tc.endpoint+tc.query, "", nil)
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+
+			if tc.status == http.StatusOK {
+				require.Equal(t, ContentTypeOctetStream, rr.Header().Get("Content-Type"))
+				require.Equal(t, tc.written, rr.Body.Bytes())
+				gateway.AssertExpectations(t)
+				return
+			}
+
+			require.True(t, strings.HasPrefix(rr.Header().Get("Content-Type"), ContentTypeJSON))
+
+			var rsp ReceivedHTTPResponse
+			err := json.NewDecoder(rr.Body).Decode(&rsp)
+			require.NoError(t, err)
+			require.Equal(t, tc.httpResponse.Error, rsp.Error)
+
+			gateway.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	"github.com/gogo/protobuf/proto"
+
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+)
+
+// Types of HTTPError mapped from the FailureType of a firmware Failure message
+const (
+	ErrorTypeUnexpectedMessage = "unexpected_message"
+	ErrorTypeButtonExpected    = "button_expected"
+	ErrorTypeDataError         = "data_error"
+	ErrorTypeActionCancelled   = "action_cancelled"
+	ErrorTypePinExpected       = "pin_expected"
+	ErrorTypePinCancelled      = "pin_cancelled"
+	ErrorTypePinInvalid        = "pin_invalid"
+	ErrorTypeInvalidSignature  = "invalid_signature"
+	ErrorTypeProcessError      = "process_error"
+	ErrorTypeNotEnoughFunds    = "not_enough_funds"
+	ErrorTypeNotInitialized    = "not_initialized"
+	ErrorTypePinMismatch       = "pin_mismatch"
+	ErrorTypeAddressGeneration = "address_generation"
+	ErrorTypeFirmwarePanic     = "firmware_panic"
+	ErrorTypeFirmwareError     = "firmware_error"
+	// ErrorTypeFailure is the type of a Failure without a known FailureType
+	ErrorTypeFailure = "failure"
+)
+
+// failureError is the type and HTTP status of the error of a firmware Failure
+type failureError struct {
+	errorType string
+	status    int
+}
+
+// failureErrors maps the FailureType of a firmware Failure to its error.
+// The device refusing a request in its current state is a conflict, invalid user input is unprocessable,
+// a wrong pin code is forbidden and an error of the firmware itself is an internal error.
+var failureErrors = map[messages.FailureType]failureError{
+	messages.FailureType_Failure_UnexpectedMessage: {ErrorTypeUnexpectedMessage, http.StatusConflict},
+	messages.FailureType_Failure_ButtonExpected:    {ErrorTypeButtonExpected, http.StatusConflict},
+	messages.FailureType_Failure_DataError:         {ErrorTypeDataError, http.StatusUnprocessableEntity},
+	messages.FailureType_Failure_ActionCancelled:   {ErrorTypeActionCancelled, http.StatusConflict},
+	messages.FailureType_Failure_PinExpected:       {ErrorTypePinExpected, http.StatusConflict},
+	messages.FailureType_Failure_PinCancelled:      {ErrorTypePinCancelled, http.StatusConflict},
+	messages.FailureType_Failure_PinInvalid:        {ErrorTypePinInvalid, http.StatusForbidden},
+	messages.FailureType_Failure_InvalidSignature:  {ErrorTypeInvalidSignature, http.StatusUnprocessableEntity},
+	messages.FailureType_Failure_ProcessError:      {ErrorTypeProcessError, http.StatusInternalServerError},
+	messages.FailureType_Failure_NotEnoughFunds:    {ErrorTypeNotEnoughFunds, http.StatusUnprocessableEntity},
+	messages.FailureType_Failure_NotInitialized:    {ErrorTypeNotInitialized, http.StatusConflict},
+	messages.FailureType_Failure_PinMismatch:       {ErrorTypePinMismatch, http.StatusUnprocessableEntity},
+	messages.FailureType_Failure_AddressGeneration: {ErrorTypeAddressGeneration, http.StatusUnprocessableEntity},
+	messages.FailureType_Failure_FirmwarePanic:     {ErrorTypeFirmwarePanic, http.StatusInternalServerError},
+	messages.FailureType_Failure_FirmwareError:     {ErrorTypeFirmwareError, http.StatusInternalServerError},
+}
+
+// decodeFailure decodes a firmware Failure message, with its FailureType
+func decodeFailure(msg wire.Message) (*messages.Failure, error) {
+	if msg.Kind != uint16(messages.MessageType_MessageType_Failure) {
+		return nil, fmt.Errorf("calling decodeFailure with wrong message type: %s", messages.MessageType(msg.Kind))
+	}
+
+	failure := &messages.Failure{}
+	if err := proto.Unmarshal(msg.Data, failure); err != nil {
+		return nil, err
+	}
+
+	return failure, nil
+}
+
+// newFailureResponse returns the error response of a firmware Failure message
+func newFailureResponse(msg wire.Message) HTTPResponse {
+	failure, err := decodeFailure(msg)
+	if err != nil {
+		return NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+	}
+
+	return failureResponse(failure, failure.GetMessage())
+}
+
+// failureResponse returns the error response of a firmware Failure with a message
+func failureResponse(failure *messages.Failure, message string) HTTPResponse {
+	// GetCode returns the first FailureType of a Failure without a code
+	fe, ok := failureErrors[failure.GetCode()]
+	if failure.Code == nil || !ok {
+		fe = failureError{ErrorTypeFailure, http.StatusConflict}
+	}
+
+	resp := NewHTTPErrorResponse(fe.status, message)
+	resp.Error.Type = fe.errorType
+	return resp
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	"github.com/stretchr/testify/require"
+
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+)
+
+func newTypedHTTPErrorResponse(code int, errorType, msg string) HTTPResponse {
+	resp := NewHTTPErrorResponse(code, msg)
+	resp.Error.Type = errorType
+	return resp
+}
+
+func testFailureCodeMessage(t *testing.T, code messages.FailureType, msg string) wire.Message {
+	failure := messages.Failure{
+		Code:    code.Enum(),
+		Message: newStrPtr(msg),
+	}
+
+	data, err := failure.Marshal()
+	require.NoError(t, err)
+
+	return wire.Message{
+		Kind: uint16(messages.MessageType_MessageType_Failure),
+		Data: data,
+	}
+}
+
+func TestNewFailureResponse(t *testing.T) {
+	cases := []struct {
+		code      messages.FailureType
+		status    int
+		errorType string
+	}{
+		{messages.FailureType_Failure_UnexpectedMessage, http.StatusConflict, "unexpected_message"},
+		{messages.FailureType_Failure_ButtonExpected, http.StatusConflict, "button_expected"},
+		{messages.FailureType_Failure_DataError, http.StatusUnprocessableEntity, "data_error"},
+		{messages.FailureType_Failure_ActionCancelled, http.StatusConflict, "action_cancelled"},
+		{messages.FailureType_Failure_PinExpected, http.StatusConflict, "pin_expected"},
+		{messages.FailureType_Failure_PinCancelled, http.StatusConflict, "pin_cancelled"},
+		{messages.FailureType_Failure_PinInvalid, http.StatusForbidden, "pin_invalid"},
+		{messages.FailureType_Failure_InvalidSignature, http.StatusUnprocessableEntity, "invalid_signature"},
+		{messages.FailureType_Failure_ProcessError, http.StatusInternalServerError, "process_error"},
+		{messages.FailureType_Failure_NotEnoughFunds, http.StatusUnprocessableEntity, "not_enough_funds"},
+		{messages.FailureType_Failure_NotInitialized, http.StatusConflict, "not_initialized"},
+		{messages.FailureType_Failure_PinMismatch, http.StatusUnprocessableEntity, "pin_mismatch"},
+		{messages.FailureType_Failure_AddressGeneration, http.StatusUnprocessableEntity, "address_generation"},
+		{messages.FailureType_Failure_FirmwarePanic, http.StatusInternalServerError, "firmware_panic"},
+		{messages.FailureType_Failure_FirmwareError, http.StatusInternalServerError, "firmware_error"},
+		{messages.FailureType(50), http.StatusConflict, "failure"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.errorType, func(t *testing.T) {
+			resp := newFailureResponse(testFailureCodeMessage(t, tc.code, "failure msg"))
+			require.Equal(t, &HTTPError{
+				Code:    tc.status,
+				Message: "failure msg",
+				Type:    tc.errorType,
+			}, resp.Error)
+		})
+	}
+
+	// every FailureType is mapped
+	for code := range messages.FailureType_name {
+		_, ok := failureErrors[messages.FailureType(code)]
+		require.True(t, ok, messages.FailureType(code).String())
+	}
+
+	// a Failure without a code
+	data, err := (&messages.Failure{Message: newStrPtr("failure msg")}).Marshal()
+	require.NoError(t, err)
+	resp := newFailureResponse(wire.Message{
+		Kind: uint16(messages.MessageType_MessageType_Failure),
+		Data: data,
+	})
+	require.Equal(t, newTypedHTTPErrorResponse(http.StatusConflict, ErrorTypeFailure, "failure msg"), resp)
+
+	resp = newFailureResponse(buttonRequestMessage)
+	require.Equal(t, NewHTTPErrorResponse(http.StatusInternalServerError, "calling decodeFailure with wrong message type: MessageType_ButtonRequest"), resp)
+}
+
+func TestFailureStatus(t *testing.T) {
+	gateway := &MockGatewayer{}
+	gateway.On("ChangePin", newBoolPtr(false)).Return(testFailureCodeMessage(t, messages.FailureType_Failure_PinMismatch, "PIN mismatch"), nil)
+
+	handler := newServerMux(defaultMuxConfig(), gateway)
+
+	rr := serveTestRequest(t, handler, http.MethodPost, "/configure_pin_code", toJSON(t, ConfigurePinCodeRequest{
+		RemovePin: false,
+	}), map[string]string{
+		"Content-Type": ContentTypeJSON,
+	})
+	require.Equal(t, http.StatusUnprocessableEntity, rr.Code, rr.Body.String())
+	require.JSONEq(t, `{"error":{"code":422,"message":"PIN mismatch","type":"pin_mismatch"}}`, rr.Body.String())
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"crypto/sha256"
+	"encoding/hex"
 	"io/ioutil"
 	"net/http"
 )
@@ -15,7 +16,7 @@ const (
 // Method: PUT
 // Args:
 //  file: firmware file
-func firmwareUpdate(gateway Gatewayer) http.HandlerFunc {
+func firmwareUpdate(gateway Gatewayer, auditLog *AuditLog) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPut {
 			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
@@ -45,12 +46,18 @@ func firmwareUpdate(gateway Gatewayer) http.HandlerFunc {
 			return
 		}
 
+		hash := sha256.Sum256(fileBytes[0x100:])
+		auditEntry := auditLog.begin(r, gateway, "firmware_update", auditFirmwareUpdate{
+			Size: len(fileBytes),
+			Hash: hex.EncodeToString(hash[:]),
+		})
+
 		retCH := make(chan int)
 		errCH := make(chan int)
 		ctx := r.Context()
 
 		go func() {
-			err = gateway.FirmwareUpload(fileBytes, sha256.Sum256(fileBytes[0x100:]))
+			err = gateway.FirmwareUpload(fileBytes, hash)
 			if err != nil {
 				errCH <- 1
 				return
@@ -60,12 +67,15 @@ func firmwareUpdate(gateway Gatewayer) http.HandlerFunc {
 
 		select {
 		case <-retCH:
+			auditLog.finishWithResult(auditEntry, auditResultSuccess, "")
 			writeHTTPResponse(w, HTTPResponse{})
 		case <-errCH:
 			logger.Errorf("firmwareUpdate failed: %s", err.Error())
+			auditLog.finishWithResult(auditEntry, auditResultError, err.Error())
 			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
 			writeHTTPResponse(w, resp)
 		case <-ctx.Done():
+			auditLog.finishWithResult(auditEntry, auditResultClosed, "")
 			disConnErr := gateway.Disconnect()
 			if disConnErr != nil {
 				resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
@@ -1,24 +1,203 @@
 package api
 
 import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"sync"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/usb"
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	"github.com/gogo/protobuf/proto"
+
 	skyWallet "github.com/SkycoinProject/hardware-wallet-go/src/skywallet"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
 )
 
 //go:generate mockery -name Gatewayer -case underscore -inpkg -testonly
 
+var (
+	// ErrLoadDeviceNotEmulator is returned if a device is loaded while the device type is not emulator
+	ErrLoadDeviceNotEmulator = errors.New("load device is only available for the emulator")
+	// ErrInvalidStrength is returned if the strength of a generated mnemonic is not 128 or 256 bits
+	ErrInvalidStrength = errors.New("strength must be 128 or 256")
+	// ErrNotConnected is returned if a message is sent while the device is not connected
+	ErrNotConnected = errors.New("device is not connected")
+)
+
+// ResetDeviceOptions are the options of a ResetDevice request, which generates a mnemonic
+// and configures the device in one firmware flow
+type ResetDeviceOptions struct {
+	// Strength is the entropy of the mnemonic in bits, 128 for 12 words and 256 for 24 words
+	Strength             uint32
+	PassphraseProtection bool
+	PinProtection        bool
+	Label                string
+	Language             string
+	// SkipBackup leaves the backup of the mnemonic for later
+	SkipBackup bool
+	// DisplayRandom shows the internal entropy of the device on its screen
+	DisplayRandom bool
+}
+
 // Gateway is the api gateway
 type Gateway struct {
-	Device *skyWallet.Device
+	*skyWallet.Device
+	driver *connectionDriver
 }
 
-// NewGateway creates a Gateway
+// NewGateway creates a Gateway. The driver of device is wrapped, so the messages which
+// skyWallet.Device does not send use the connection opened by Device.Connect.
 func NewGateway(device *skyWallet.Device) *Gateway {
+	driver, ok := device.Driver.(*connectionDriver)
+	if !ok {
+		driver = &connectionDriver{
+			DeviceDriver: device.Driver,
+		}
+		device.Driver = driver
+	}
+
 	return &Gateway{
-		device,
+		Device: device,
+		driver: driver,
+	}
+}
+
+// connectionDriver keeps the usb device opened by skyWallet.Device.Connect,
+// which skyWallet.Device does not export
+type connectionDriver struct {
+	skyWallet.DeviceDriver
+	lock sync.Mutex
+	dev  usb.Device
+}
+
+// GetDevice opens the usb device, it is called by skyWallet.Device.Connect
+func (d *connectionDriver) GetDevice() (usb.Device, error) {
+	dev, err := d.DeviceDriver.GetDevice()
+	if err != nil {
+		return nil, err
 	}
+
+	d.lock.Lock()
+	d.dev = dev
+	d.lock.Unlock()
+
+	return dev, nil
+}
+
+// connection returns the usb device of the current connection
+func (d *connectionDriver) connection() usb.Device {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.dev
 }
 
 // Gatewayer interface for Gateway methods
 type Gatewayer interface {
 	skyWallet.Devicer
+	// SaveDeviceEntropyInFile is implemented by skyWallet.Device but is not part of skyWallet.Devicer
+	SaveDeviceEntropyInFile(outFile string, entropyBytes uint32, getEntropyMsgBuilder func(entropyBytes uint32) ([][64]byte, error)) error
+	LoadDevice(mnemonic, pin string, passphraseProtection bool, label string) (wire.Message, error)
+	ResetDevice(options ResetDeviceOptions) (wire.Message, error)
+	PassphraseStateAck() (wire.Message, error)
+}
+
+// LoadDevice configures the emulator with a mnemonic, pin code, label and passphrase protection in one request,
+// without user confirmation. It is meant for test fixtures and fails if the device type is not emulator.
+func (g *Gateway) LoadDevice(mnemonic, pin string, passphraseProtection bool, label string) (wire.Message, error) {
+	if g.Driver.DeviceType() != skyWallet.DeviceTypeEmulator {
+		return wire.Message{}, ErrLoadDeviceNotEmulator
+	}
+
+	loadDevice := &messages.LoadDevice{
+		Mnemonic:             proto.String(mnemonic),
+		PassphraseProtection: proto.Bool(passphraseProtection),
+	}
+	if pin != "" {
+		loadDevice.Pin = proto.String(pin)
+	}
+	if label != "" {
+		loadDevice.Label = proto.String(label)
+	}
+
+	data, err := proto.Marshal(loadDevice)
+	if err != nil {
+		return wire.Message{}, err
+	}
+
+	return g.call(messages.MessageType_MessageType_LoadDevice, data)
+}
+
+// ResetDevice asks the device to generate a mnemonic and configure itself with the options
+func (g *Gateway) ResetDevice(options ResetDeviceOptions) (wire.Message, error) {
+	if options.Strength != 128 && options.Strength != 256 {
+		return wire.Message{}, ErrInvalidStrength
+	}
+
+	resetDevice := &messages.ResetDevice{
+		Strength:             proto.Uint32(options.Strength),
+		PassphraseProtection: proto.Bool(options.PassphraseProtection),
+		PinProtection:        proto.Bool(options.PinProtection),
+		SkipBackup:           proto.Bool(options.SkipBackup),
+		DisplayRandom:        proto.Bool(options.DisplayRandom),
+	}
+	if options.Label != "" {
+		resetDevice.Label = proto.String(options.Label)
+	}
+	if options.Language != "" {
+		resetDevice.Language = proto.String(options.Language)
+	}
+
+	data, err := proto.Marshal(resetDevice)
+	if err != nil {
+		return wire.Message{}, err
+	}
+
+	return g.call(messages.MessageType_MessageType_ResetDevice, data)
+}
+
+// PassphraseStateAck acknowledges the passphrase state sent by the device after a passphrase is entered
+func (g *Gateway) PassphraseStateAck() (wire.Message, error) {
+	data, err := proto.Marshal(&messages.PassphraseStateAck{})
+	if err != nil {
+		return wire.Message{}, err
+	}
+
+	return g.call(messages.MessageType_MessageType_PassphraseStateAck, data)
+}
+
+// call sends a message which the skywallet package does not send. Like the messages of skyWallet.Device,
+// it goes through the connection opened by Device.Connect, which serializes the device requests.
+func (g *Gateway) call(kind messages.MessageType, data []byte) (wire.Message, error) {
+	if err := g.Connect(); err != nil {
+		return wire.Message{}, err
+	}
+	defer g.Disconnect()
+
+	dev := g.driver.connection()
+	if dev == nil {
+		return wire.Message{}, ErrNotConnected
+	}
+
+	return g.Driver.SendToDevice(dev, messageChunks(kind, data))
+}
+
+// messageChunks splits a message of the device protocol in 64 bytes reports:
+// each report starts with '?', the message starts with "##", its type and the length of its data
+func messageChunks(kind messages.MessageType, data []byte) [][64]byte {
+	var message bytes.Buffer
+	message.WriteString("##")
+	binary.Write(&message, binary.BigEndian, uint16(kind))      // nolint: errcheck
+	binary.Write(&message, binary.BigEndian, uint32(len(data))) // nolint: errcheck
+	message.Write(data)
+
+	var chunks [][64]byte
+	for b := message.Bytes(); len(b) > 0; {
+		var chunk [64]byte
+		chunk[0] = '?'
+		n := copy(chunk[1:], b)
+		chunks = append(chunks, chunk)
+		b = b[n:]
+	}
+	return chunks
 }
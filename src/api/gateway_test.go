@@ -0,0 +1,214 @@
+package api
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/usb"
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	skyWallet "github.com/SkycoinProject/hardware-wallet-go/src/skywallet"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+)
+
+// testUSBDevice is a usb device which records whether it is closed
+type testUSBDevice struct {
+	closed bool
+}
+
+func (d *testUSBDevice) Read(p []byte) (int, error) {
+	return 0, errors.New("not implemented")
+}
+
+func (d *testUSBDevice) Write(p []byte) (int, error) {
+	return len(p), nil
+}
+
+func (d *testUSBDevice) Close(disconnected bool) error {
+	d.closed = true
+	return nil
+}
+
+// testDeviceDriver records the messages sent to the usb devices it opens
+type testDeviceDriver struct {
+	deviceType skyWallet.DeviceType
+	getErr     error
+	opened     []*testUSBDevice
+	sentTo     []usb.Device
+	sent       [][][64]byte
+	response   wire.Message
+}
+
+func (d *testDeviceDriver) SendToDevice(dev usb.Device, chunks [][64]byte) (wire.Message, error) {
+	d.sentTo = append(d.sentTo, dev)
+	d.sent = append(d.sent, chunks)
+	return d.response, nil
+}
+
+func (d *testDeviceDriver) SendToDeviceNoAnswer(dev usb.Device, chunks [][64]byte) error {
+	return errors.New("not implemented")
+}
+
+func (d *testDeviceDriver) GetDevice() (usb.Device, error) {
+	if d.getErr != nil {
+		return nil, d.getErr
+	}
+
+	dev := &testUSBDevice{}
+	d.opened = append(d.opened, dev)
+	return dev, nil
+}
+
+func (d *testDeviceDriver) GetDeviceInfos() ([]usb.Info, error) {
+	return nil, nil
+}
+
+func (d *testDeviceDriver) DeviceType() skyWallet.DeviceType {
+	return d.deviceType
+}
+
+func (d *testDeviceDriver) Close() {}
+
+// decodeChunks returns the type and the data of a message split by messageChunks
+func decodeChunks(t *testing.T, chunks [][64]byte) (messages.MessageType, []byte) {
+	require.NotEmpty(t, chunks)
+	require.Equal(t, []byte{'?', '#', '#'}, chunks[0][:3])
+
+	kind := binary.BigEndian.Uint16(chunks[0][3:5])
+	length := binary.BigEndian.Uint32(chunks[0][5:9])
+	data := append([]byte{}, chunks[0][9:]...)
+	for _, chunk := range chunks[1:] {
+		require.Equal(t, byte('?'), chunk[0])
+		data = append(data, chunk[1:]...)
+	}
+
+	require.True(t, int(length) <= len(data))
+	return messages.MessageType(kind), data[:length]
+}
+
+func TestGatewayLoadDevice(t *testing.T) {
+	driver := &testDeviceDriver{
+		deviceType: skyWallet.DeviceTypeEmulator,
+		response:   testSuccessMessage(t, "Device loaded"),
+	}
+	gateway := NewGateway(&skyWallet.Device{Driver: driver})
+
+	msg, err := gateway.LoadDevice("cloud flower upset", "1234", true, "fixture")
+	require.NoError(t, err)
+	require.Equal(t, driver.response, msg)
+
+	// the message is sent through the connection of the device, which is closed afterwards
+	require.Len(t, driver.opened, 1)
+	require.Equal(t, []usb.Device{driver.opened[0]}, driver.sentTo)
+	require.True(t, driver.opened[0].closed)
+
+	kind, data := decodeChunks(t, driver.sent[0])
+	require.Equal(t, messages.MessageType_MessageType_LoadDevice, kind)
+
+	var loadDevice messages.LoadDevice
+	err = proto.Unmarshal(data, &loadDevice)
+	require.NoError(t, err)
+	require.Equal(t, "cloud flower upset", loadDevice.GetMnemonic())
+	require.Equal(t, "1234", loadDevice.GetPin())
+	require.True(t, loadDevice.GetPassphraseProtection())
+	require.Equal(t, "fixture", loadDevice.GetLabel())
+}
+
+func TestGatewayLoadDeviceNotEmulator(t *testing.T) {
+	driver := &testDeviceDriver{
+		deviceType: skyWallet.DeviceTypeUSB,
+	}
+	gateway := NewGateway(&skyWallet.Device{Driver: driver})
+
+	_, err := gateway.LoadDevice("cloud flower upset", "", false, "")
+	require.Equal(t, ErrLoadDeviceNotEmulator, err)
+	require.Empty(t, driver.opened)
+}
+
+func TestGatewayResetDevice(t *testing.T) {
+	driver := &testDeviceDriver{
+		deviceType: skyWallet.DeviceTypeUSB,
+		response:   testButtonRequestMessage(t, messages.ButtonRequestType_ButtonRequest_ResetDevice),
+	}
+	gateway := NewGateway(&skyWallet.Device{Driver: driver})
+
+	msg, err := gateway.ResetDevice(ResetDeviceOptions{
+		Strength:      256,
+		PinProtection: true,
+		Label:         "wallet",
+		SkipBackup:    true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, driver.response, msg)
+
+	require.Len(t, driver.opened, 1)
+	require.Equal(t, []usb.Device{driver.opened[0]}, driver.sentTo)
+	require.True(t, driver.opened[0].closed)
+
+	kind, data := decodeChunks(t, driver.sent[0])
+	require.Equal(t, messages.MessageType_MessageType_ResetDevice, kind)
+
+	var resetDevice messages.ResetDevice
+	err = proto.Unmarshal(data, &resetDevice)
+	require.NoError(t, err)
+	require.Equal(t, uint32(256), resetDevice.GetStrength())
+	require.True(t, resetDevice.GetPinProtection())
+	require.False(t, resetDevice.GetPassphraseProtection())
+	require.Equal(t, "wallet", resetDevice.GetLabel())
+	require.True(t, resetDevice.GetSkipBackup())
+}
+
+func TestGatewayPassphraseStateAck(t *testing.T) {
+	driver := &testDeviceDriver{
+		deviceType: skyWallet.DeviceTypeUSB,
+		response:   testSuccessMessage(t, "Passphrase state acknowledged"),
+	}
+	gateway := NewGateway(&skyWallet.Device{Driver: driver})
+
+	msg, err := gateway.PassphraseStateAck()
+	require.NoError(t, err)
+	require.Equal(t, driver.response, msg)
+
+	require.Len(t, driver.opened, 1)
+	require.Equal(t, []usb.Device{driver.opened[0]}, driver.sentTo)
+	require.True(t, driver.opened[0].closed)
+
+	kind, data := decodeChunks(t, driver.sent[0])
+	require.Equal(t, messages.MessageType_MessageType_PassphraseStateAck, kind)
+	require.Empty(t, data)
+}
+
+func TestGatewayConnectError(t *testing.T) {
+	driver := &testDeviceDriver{
+		deviceType: skyWallet.DeviceTypeUSB,
+		getErr:     errors.New("no device"),
+	}
+	gateway := NewGateway(&skyWallet.Device{Driver: driver})
+
+	_, err := gateway.ResetDevice(ResetDeviceOptions{
+		Strength: 128,
+	})
+	require.EqualError(t, err, "no device")
+	require.Empty(t, driver.sent)
+}
+
+func TestNewGatewayWrapsDriverOnce(t *testing.T) {
+	device := &skyWallet.Device{Driver: &testDeviceDriver{}}
+	first := NewGateway(device)
+	second := NewGateway(device)
+	require.Equal(t, first.driver, second.driver)
+	require.Equal(t, first.driver, device.Driver)
+}
+
+func TestResetDeviceInvalidStrength(t *testing.T) {
+	gateway := &Gateway{}
+	for _, strength := range []uint32{0, 192, 512} {
+		_, err := gateway.ResetDevice(ResetDeviceOptions{
+			Strength: strength,
+		})
+		require.Equal(t, ErrInvalidStrength, err)
+	}
+}
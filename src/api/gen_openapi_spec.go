@@ -0,0 +1,32 @@
+// +build ignore
+
+// gen_openapi_spec.go embeds swagger.yml in openapi_spec.go, it is run by go generate
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+)
+
+func main() {
+	spec, err := ioutil.ReadFile("../../swagger.yml")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if bytes.ContainsRune(spec, '`') {
+		log.Fatal("swagger.yml cannot contain a backquote, it is embedded in a raw string literal")
+	}
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen_openapi_spec.go; DO NOT EDIT.\n\n")
+	b.WriteString("package api\n\n")
+	b.WriteString("// openAPISpecYAML is swagger.yml, the OpenAPI spec of /api/v1\n")
+	fmt.Fprintf(&b, "const openAPISpecYAML = `%s`\n", spec)
+
+	if err := ioutil.WriteFile("openapi_spec.go", b.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
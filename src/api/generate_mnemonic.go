@@ -2,6 +2,7 @@ package api
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
@@ -13,12 +14,44 @@ import (
 type GenerateMnemonicRequest struct {
 	WordCount     uint32 `json:"word_count"`
 	UsePassphrase bool   `json:"use_passphrase"`
+	// The options below are those of ResetDevice, the mnemonic is generated with it if any of them is set
+	PinProtection bool   `json:"pin_protection"`
+	Label         string `json:"label"`
+	Language      string `json:"language"`
+	SkipBackup    bool   `json:"skip_backup"`
+	DisplayRandom bool   `json:"display_random"`
+	// Strength is the entropy of the mnemonic in bits, it must match the word count
+	Strength uint32 `json:"strength"`
+}
+
+// wordCountStrength maps the word count of a mnemonic to its strength in bits
+var wordCountStrength = map[uint32]uint32{
+	12: 128,
+	24: 256,
+}
+
+// resetDeviceOptions returns the ResetDevice options of the request,
+// or nil if it only sets the options of GenerateMnemonic
+func (req GenerateMnemonicRequest) resetDeviceOptions() *ResetDeviceOptions {
+	if !req.PinProtection && req.Label == "" && req.Language == "" && !req.SkipBackup && !req.DisplayRandom && req.Strength == 0 {
+		return nil
+	}
+
+	return &ResetDeviceOptions{
+		Strength:             wordCountStrength[req.WordCount],
+		PassphraseProtection: req.UsePassphrase,
+		PinProtection:        req.PinProtection,
+		Label:                req.Label,
+		Language:             req.Language,
+		SkipBackup:           req.SkipBackup,
+		DisplayRandom:        req.DisplayRandom,
+	}
 }
 
 // URI: /api/v1/generate_mnemonic
 // Method: POST
 // Args: JSON Body
-func generateMnemonic(gateway Gatewayer) http.HandlerFunc {
+func generateMnemonic(gateway Gatewayer, addressBook *AddressBook) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
@@ -40,14 +73,27 @@ func generateMnemonic(gateway Gatewayer) http.HandlerFunc {
 		}
 		defer r.Body.Close()
 
-		if req.WordCount != 12 && req.WordCount != 24 {
-			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-				resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, "word count must be 12 or 24")
-				writeHTTPResponse(w, resp)
-				return
-			}
+		strength, ok := wordCountStrength[req.WordCount]
+		if !ok {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, "word count must be 12 or 24")
+			writeHTTPResponse(w, resp)
+			return
 		}
 
+		if req.Strength != 0 && req.Strength != 128 && req.Strength != 256 {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, ErrInvalidStrength.Error())
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if req.Strength != 0 && req.Strength != strength {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, fmt.Sprintf("strength of %d words must be %d", req.WordCount, strength))
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		resetOptions := req.resetDeviceOptions()
+
 		// for integration tests
 		if autoPressEmulatorButtons {
 			err := gateway.SetAutoPressButton(true, skyWallet.ButtonRight)
@@ -59,6 +105,8 @@ func generateMnemonic(gateway Gatewayer) http.HandlerFunc {
 			}
 		}
 
+		addressBookDone := addressBook.reset(gateway)
+
 		var msg wire.Message
 		var err error
 		retCH := make(chan int)
@@ -66,7 +114,11 @@ func generateMnemonic(gateway Gatewayer) http.HandlerFunc {
 		ctx := r.Context()
 
 		go func() {
-			msg, err = gateway.GenerateMnemonic(req.WordCount, req.UsePassphrase)
+			if resetOptions != nil {
+				msg, err = gateway.ResetDevice(*resetOptions)
+			} else {
+				msg, err = gateway.GenerateMnemonic(req.WordCount, req.UsePassphrase)
+			}
 			if err != nil {
 				errCH <- 1
 				return
@@ -76,6 +128,7 @@ func generateMnemonic(gateway Gatewayer) http.HandlerFunc {
 
 		select {
 		case <-retCH:
+			addressBook.finish(gateway, addressBookDone, msg)
 			HandleFirmwareResponseMessages(w, msg)
 		case <-errCH:
 			logger.Errorf("generateMnemonic failed: %s", err.Error())
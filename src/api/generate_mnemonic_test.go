@@ -36,6 +36,7 @@ func TestGenerateMnemonic(t *testing.T) {
 		httpBody                      string
 		httpResponse                  HTTPResponse
 		gatewayGenerateMnemonicResult wire.Message
+		gatewayResetDeviceOptions     *ResetDeviceOptions
 	}{
 		{
 			name:         "405",
@@ -70,11 +71,33 @@ func TestGenerateMnemonic(t *testing.T) {
 			httpResponse: NewHTTPErrorResponse(http.StatusUnprocessableEntity, "word count must be 12 or 24"),
 		},
 
+		{
+			name:   "422 - invalid strength",
+			method: http.MethodPost,
+			status: http.StatusUnprocessableEntity,
+			httpBody: toJSON(t, &GenerateMnemonicRequest{
+				WordCount: 12,
+				Strength:  192,
+			}),
+			httpResponse: NewHTTPErrorResponse(http.StatusUnprocessableEntity, "strength must be 128 or 256"),
+		},
+
+		{
+			name:   "422 - strength does not match word count",
+			method: http.MethodPost,
+			status: http.StatusUnprocessableEntity,
+			httpBody: toJSON(t, &GenerateMnemonicRequest{
+				WordCount: 12,
+				Strength:  256,
+			}),
+			httpResponse: NewHTTPErrorResponse(http.StatusUnprocessableEntity, "strength of 12 words must be 128"),
+		},
+
 		{
 			name:         "409 - Failure msg",
 			method:       http.MethodPost,
 			status:       http.StatusConflict,
-			httpResponse: NewHTTPErrorResponse(http.StatusConflict, "failure msg"),
+			httpResponse: newTypedHTTPErrorResponse(http.StatusConflict, ErrorTypeNotInitialized, "failure msg"),
 			httpBody: toJSON(t, &GenerateMnemonicRequest{
 				WordCount: 12,
 			}),
@@ -99,6 +122,33 @@ func TestGenerateMnemonic(t *testing.T) {
 				Data: successMsgBytes,
 			},
 		},
+
+		{
+			name:   "200 - OK with ResetDevice options",
+			method: http.MethodPost,
+			status: http.StatusOK,
+			httpResponse: HTTPResponse{
+				Data: []string{*successMsg.Message},
+			},
+			httpBody: toJSON(t, &GenerateMnemonicRequest{
+				WordCount:     24,
+				UsePassphrase: true,
+				PinProtection: true,
+				Label:         "cold storage",
+				SkipBackup:    true,
+			}),
+			gatewayResetDeviceOptions: &ResetDeviceOptions{
+				Strength:             256,
+				PassphraseProtection: true,
+				PinProtection:        true,
+				Label:                "cold storage",
+				SkipBackup:           true,
+			},
+			gatewayGenerateMnemonicResult: wire.Message{
+				Kind: uint16(messages.MessageType_MessageType_Success),
+				Data: successMsgBytes,
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -111,6 +161,9 @@ func TestGenerateMnemonic(t *testing.T) {
 			if err == nil {
 				gateway.On("GenerateMnemonic", body.WordCount, body.UsePassphrase).Return(tc.gatewayGenerateMnemonicResult, nil)
 			}
+			if tc.gatewayResetDeviceOptions != nil {
+				gateway.On("ResetDevice", *tc.gatewayResetDeviceOptions).Return(tc.gatewayGenerateMnemonicResult, nil)
+			}
 
 			req, err := http.NewRequest(tc.method, "/api/v1"+endpoint, strings.NewReader(tc.httpBody))
 			require.NoError(t, err)
@@ -146,6 +199,11 @@ func TestGenerateMnemonic(t *testing.T) {
 
 				require.Equal(t, tc.httpResponse.Data, resp)
 			}
+
+			if tc.gatewayResetDeviceOptions != nil {
+				gateway.AssertCalled(t, "ResetDevice", *tc.gatewayResetDeviceOptions)
+				gateway.AssertNotCalled(t, "GenerateMnemonic", body.WordCount, body.UsePassphrase)
+			}
 		})
 	}
 }
@@ -0,0 +1,504 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	"github.com/SkycoinProject/skycoin/src/cipher"
+	"github.com/SkycoinProject/skycoin/src/util/droplet"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+)
+
+// errGRPCOperationInProgress is returned by a gRPC call made while another one uses the device
+var errGRPCOperationInProgress = status.Error(codes.Unavailable, "another device operation is in progress")
+
+// failureCodes maps the FailureType of a firmware Failure to the status of a gRPC call,
+// as failureErrors maps it to an HTTP status
+var failureCodes = map[messages.FailureType]codes.Code{
+	messages.FailureType_Failure_UnexpectedMessage: codes.FailedPrecondition,
+	messages.FailureType_Failure_ButtonExpected:    codes.FailedPrecondition,
+	messages.FailureType_Failure_DataError:         codes.InvalidArgument,
+	messages.FailureType_Failure_ActionCancelled:   codes.Canceled,
+	messages.FailureType_Failure_PinExpected:       codes.FailedPrecondition,
+	messages.FailureType_Failure_PinCancelled:      codes.Canceled,
+	messages.FailureType_Failure_PinInvalid:        codes.PermissionDenied,
+	messages.FailureType_Failure_InvalidSignature:  codes.InvalidArgument,
+	messages.FailureType_Failure_ProcessError:      codes.Internal,
+	messages.FailureType_Failure_NotEnoughFunds:    codes.InvalidArgument,
+	messages.FailureType_Failure_NotInitialized:    codes.FailedPrecondition,
+	messages.FailureType_Failure_PinMismatch:       codes.InvalidArgument,
+	messages.FailureType_Failure_AddressGeneration: codes.InvalidArgument,
+	messages.FailureType_Failure_FirmwarePanic:     codes.Internal,
+	messages.FailureType_Failure_FirmwareError:     codes.Internal,
+}
+
+// GRPCServer serves the HardwareWallet gRPC service of hardware_wallet.proto
+type GRPCServer struct {
+	server   *grpc.Server
+	listener net.Listener
+	done     chan struct{}
+}
+
+// CreateGRPC creates a gRPC server listening on host. The service uses the device gateway,
+// the audit log and the address book of s.
+func CreateGRPC(host string, s *Server) (*GRPCServer, error) {
+	listener, err := net.Listen("tcp", host)
+	if err != nil {
+		return nil, err
+	}
+
+	srv := grpc.NewServer()
+	RegisterHardwareWalletServer(srv, newHardwareWalletService(s.gateway, s.auditLog, s.addressBook, s.maxAddressIndex))
+
+	return &GRPCServer{
+		server:   srv,
+		listener: listener,
+		done:     make(chan struct{}),
+	}, nil
+}
+
+// Addr returns the address the gRPC server listens on
+func (s *GRPCServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Serve serves the gRPC service
+func (s *GRPCServer) Serve() error {
+	defer close(s.done)
+
+	return s.server.Serve(s.listener)
+}
+
+// Shutdown closes the gRPC service and the calls in progress. This can only be called after Serve has been called.
+func (s *GRPCServer) Shutdown() {
+	if s == nil {
+		return
+	}
+
+	logger.Info("Shutting down gRPC interface")
+	defer logger.Info("gRPC interface shut down")
+	s.server.Stop()
+	<-s.done
+}
+
+// hardwareWalletService implements HardwareWalletServer with the device gateway.
+// The device runs one operation at a time, so the calls of the service are run one at a time.
+type hardwareWalletService struct {
+	gateway         Gatewayer
+	auditLog        *AuditLog
+	addressBook     *AddressBook
+	maxAddressIndex uint32
+
+	lock sync.Mutex
+	// operation is the call using the device, nil if there is none
+	operation *grpcOperation
+}
+
+// grpcOperation is a call of the service using the device
+type grpcOperation struct {
+	// waiting is the kind of the device request the operation waits for the client to answer, 0 if it does not wait
+	waiting uint16
+	// answers receives the answer of the device to the client
+	answers chan grpcAnswer
+}
+
+// grpcAnswer is the answer of the device to a PinMatrixAck, PassphraseAck or Cancel call
+type grpcAnswer struct {
+	msg wire.Message
+	err error
+}
+
+func newHardwareWalletService(gateway Gatewayer, auditLog *AuditLog, addressBook *AddressBook, maxAddressIndex uint32) *hardwareWalletService {
+	return &hardwareWalletService{
+		gateway:         gateway,
+		auditLog:        auditLog,
+		addressBook:     addressBook,
+		maxAddressIndex: maxAddressIndex,
+	}
+}
+
+// Features returns the features of the device
+func (s *hardwareWalletService) Features(ctx context.Context, req *messages.GetFeatures) (*messages.Features, error) {
+	var features *messages.Features
+	err := s.run(func(op *grpcOperation) error {
+		msg, err := s.call(ctx, s.gateway.GetFeatures)
+		if err != nil {
+			return err
+		}
+
+		switch msg.Kind {
+		case uint16(messages.MessageType_MessageType_Features):
+			features = &messages.Features{}
+			if err := proto.Unmarshal(msg.Data, features); err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+			return nil
+		case uint16(messages.MessageType_MessageType_Failure):
+			return failureStatus(msg)
+		default:
+			return status.Errorf(codes.Internal, "received unexpected response message type: %s", messages.MessageType(msg.Kind))
+		}
+	})
+
+	return features, err
+}
+
+// AddressGen streams the addresses of the device, generated in batches of at most maxAddressN addresses
+func (s *hardwareWalletService) AddressGen(req *messages.SkycoinAddress, stream DeviceMessageSender) error {
+	addressN := req.GetAddressN()
+	startIndex := req.GetStartIndex()
+
+	if addressN == 0 {
+		return status.Error(codes.InvalidArgument, "address_n cannot be 0")
+	}
+
+	if uint64(startIndex)+uint64(addressN)-1 > uint64(s.maxAddressIndex) {
+		return status.Errorf(codes.OutOfRange, "addresses above index %d cannot be generated", s.maxAddressIndex)
+	}
+
+	if addressN > maxAddressN && req.GetConfirmAddress() {
+		return status.Errorf(codes.InvalidArgument, "confirm_address cannot be used with more than %d addresses", maxAddressN)
+	}
+
+	ctx := stream.Context()
+	return s.run(func(op *grpcOperation) error {
+		for generated := uint32(0); generated < addressN; {
+			n := addressN - generated
+			if n > maxAddressN {
+				n = maxAddressN
+			}
+			start := startIndex + generated
+
+			if _, err := s.interact(ctx, op, stream, func() (wire.Message, error) {
+				return s.gateway.AddressGen(n, start, req.GetConfirmAddress())
+			}); err != nil {
+				return err
+			}
+
+			generated += n
+		}
+
+		return nil
+	})
+}
+
+// SignMessage streams the signature of a message by an address of the device
+func (s *hardwareWalletService) SignMessage(req *messages.SkycoinSignMessage, stream DeviceMessageSender) error {
+	if req.GetMessage() == "" {
+		return status.Error(codes.InvalidArgument, "message is required")
+	}
+
+	ctx := stream.Context()
+	return s.run(func(op *grpcOperation) error {
+		auditEntry := s.auditLog.beginFrom(grpcOrigin(ctx), "", s.gateway, "sign_message", auditSignMessage{
+			AddressN: int(req.GetAddressN()),
+			Message:  req.GetMessage(),
+		})
+
+		msg, err := s.interact(ctx, op, stream, func() (wire.Message, error) {
+			return s.gateway.SignMessage(int(req.GetAddressN()), req.GetMessage())
+		})
+		s.audit(auditEntry, msg, err)
+		return err
+	})
+}
+
+// TransactionSign streams the signatures of the inputs of a transaction
+func (s *hardwareWalletService) TransactionSign(req *messages.TransactionSign, stream DeviceMessageSender) error {
+	inputs := req.GetTransactionIn()
+	outputs := req.GetTransactionOut()
+
+	if len(inputs) == 0 {
+		return status.Error(codes.InvalidArgument, "inputs are required")
+	}
+
+	for _, input := range inputs {
+		if input.GetHashIn() == "" {
+			return status.Error(codes.InvalidArgument, "input hash cannot be empty")
+		}
+	}
+
+	for _, output := range outputs {
+		if _, err := cipher.DecodeBase58Address(output.GetAddress()); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := stream.Context()
+	return s.run(func(op *grpcOperation) error {
+		auditEntry := s.auditLog.beginFrom(grpcOrigin(ctx), "", s.gateway, "transaction_sign", newAuditTransactionSignMessage(req))
+
+		msg, err := s.interact(ctx, op, stream, func() (wire.Message, error) {
+			return s.gateway.TransactionSign(inputs, outputs)
+		})
+		s.audit(auditEntry, msg, err)
+		return err
+	})
+}
+
+// PinMatrixAck sends the pin code to the device, its answer goes on the stream waiting for the pin code
+func (s *hardwareWalletService) PinMatrixAck(ctx context.Context, req *messages.PinMatrixAck) (*empty.Empty, error) {
+	return s.answer(messages.MessageType_MessageType_PinMatrixRequest, func() (wire.Message, error) {
+		return s.gateway.PinMatrixAck(req.GetPin())
+	})
+}
+
+// PassphraseAck sends the passphrase to the device, its answer goes on the stream waiting for the passphrase
+func (s *hardwareWalletService) PassphraseAck(ctx context.Context, req *messages.PassphraseAck) (*empty.Empty, error) {
+	return s.answer(messages.MessageType_MessageType_PassphraseRequest, func() (wire.Message, error) {
+		// a different passphrase derives different addresses
+		s.addressBook.forgetSessions()
+
+		return s.gateway.PassphraseAck(req.GetPassphrase())
+	})
+}
+
+// Cancel cancels the operation of the device. The answer of the device goes on the stream
+// waiting for a pin code or passphrase, if there is one.
+func (s *hardwareWalletService) Cancel(ctx context.Context, req *messages.Cancel) (*empty.Empty, error) {
+	s.lock.Lock()
+	op := s.operation
+	if op != nil && op.waiting == 0 {
+		// the device answers the call of the operation itself
+		op = nil
+	}
+	if op != nil {
+		op.waiting = 0
+	}
+	s.lock.Unlock()
+
+	msg, err := s.gateway.Cancel()
+	if err != nil {
+		err = status.Error(codes.Internal, err.Error())
+	}
+
+	if op != nil {
+		op.answers <- grpcAnswer{msg, err}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// run runs fn as the operation using the device, it fails if another call uses the device
+func (s *hardwareWalletService) run(fn func(op *grpcOperation) error) error {
+	s.lock.Lock()
+	if s.operation != nil {
+		s.lock.Unlock()
+		return errGRPCOperationInProgress
+	}
+	op := &grpcOperation{
+		answers: make(chan grpcAnswer, 1),
+	}
+	s.operation = op
+	s.lock.Unlock()
+
+	defer func() {
+		s.lock.Lock()
+		s.operation = nil
+		s.lock.Unlock()
+	}()
+
+	return fn(op)
+}
+
+// call calls the device. The device is disconnected if the client goes away first.
+func (s *hardwareWalletService) call(ctx context.Context, call func() (wire.Message, error)) (wire.Message, error) {
+	var msg wire.Message
+	var err error
+	retCH := make(chan int, 1)
+	errCH := make(chan int, 1)
+
+	go func() {
+		msg, err = call()
+		if err != nil {
+			errCH <- 1
+			return
+		}
+		retCH <- 1
+	}()
+
+	select {
+	case <-retCH:
+		return msg, nil
+	case <-errCH:
+		return wire.Message{}, status.Error(codes.Internal, err.Error())
+	case <-ctx.Done():
+		if err := s.gateway.Disconnect(); err != nil {
+			logger.WithError(err).Warning("gRPC: gateway.Disconnect failed")
+		}
+		return wire.Message{}, status.Error(codes.Canceled, ctx.Err().Error())
+	}
+}
+
+// interact sends the messages of a device call on the stream until the final answer of the device,
+// which it returns. Button and passphrase state requests are acknowledged, pin code and passphrase
+// requests wait for the client. A Failure of the device is returned with its status.
+func (s *hardwareWalletService) interact(ctx context.Context, op *grpcOperation, stream DeviceMessageSender, call func() (wire.Message, error)) (wire.Message, error) {
+	msg, err := s.call(ctx, call)
+	for {
+		if err != nil {
+			return wire.Message{}, err
+		}
+
+		if err := stream.Send(newDeviceMessage(msg)); err != nil {
+			return wire.Message{}, err
+		}
+
+		switch msg.Kind {
+		case uint16(messages.MessageType_MessageType_ButtonRequest):
+			msg, err = s.call(ctx, s.gateway.ButtonAck)
+		case uint16(messages.MessageType_MessageType_PassphraseStateRequest):
+			msg, err = s.call(ctx, s.gateway.PassphraseStateAck)
+		case uint16(messages.MessageType_MessageType_PinMatrixRequest),
+			uint16(messages.MessageType_MessageType_PassphraseRequest):
+			msg, err = s.wait(ctx, op, msg.Kind)
+		case uint16(messages.MessageType_MessageType_Failure):
+			return msg, failureStatus(msg)
+		default:
+			return msg, nil
+		}
+	}
+}
+
+// wait waits for the answer of the device to the client, after a pin code or passphrase request.
+// If the client goes away first, the operation of the device is cancelled.
+func (s *hardwareWalletService) wait(ctx context.Context, op *grpcOperation, kind uint16) (wire.Message, error) {
+	s.lock.Lock()
+	op.waiting = kind
+	s.lock.Unlock()
+
+	select {
+	case answer := <-op.answers:
+		return answer.msg, answer.err
+	case <-ctx.Done():
+		s.lock.Lock()
+		waiting := op.waiting != 0
+		op.waiting = 0
+		s.lock.Unlock()
+
+		// the device keeps waiting for the pin code or passphrase until it is cancelled
+		if waiting {
+			if _, err := s.gateway.Cancel(); err != nil {
+				logger.WithError(err).Warning("gRPC: gateway.Cancel failed")
+			}
+		}
+		return wire.Message{}, status.Error(codes.Canceled, ctx.Err().Error())
+	}
+}
+
+// answer sends the answer of the client to the device, for the operation waiting for it
+func (s *hardwareWalletService) answer(kind messages.MessageType, call func() (wire.Message, error)) (*empty.Empty, error) {
+	s.lock.Lock()
+	op := s.operation
+	if op == nil || op.waiting != uint16(kind) {
+		s.lock.Unlock()
+		return nil, status.Errorf(codes.FailedPrecondition, "no device operation is waiting for a %s", kind)
+	}
+	op.waiting = 0
+	s.lock.Unlock()
+
+	msg, err := call()
+	if err != nil {
+		err = status.Error(codes.Internal, err.Error())
+	}
+
+	op.answers <- grpcAnswer{msg, err}
+
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// audit records the result of an audited call
+func (s *hardwareWalletService) audit(entry *AuditEntry, msg wire.Message, err error) {
+	switch {
+	case err == nil || msg.Kind == uint16(messages.MessageType_MessageType_Failure):
+		s.auditLog.finish(entry, msg)
+	case status.Code(err) == codes.Canceled:
+		s.auditLog.finishWithResult(entry, auditResultClosed, "")
+	default:
+		s.auditLog.finishWithResult(entry, auditResultError, status.Convert(err).Message())
+	}
+}
+
+// newDeviceMessage returns the DeviceMessage of a message of the device
+func newDeviceMessage(msg wire.Message) *DeviceMessage {
+	data := msg.Data
+	if data == nil {
+		data = []byte{}
+	}
+
+	return &DeviceMessage{
+		Kind: messages.MessageType(msg.Kind).Enum(),
+		Data: data,
+	}
+}
+
+// failureStatus returns the status of a firmware Failure message
+func failureStatus(msg wire.Message) error {
+	failure, err := decodeFailure(msg)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	// GetCode returns the first FailureType of a Failure without a code
+	code, ok := failureCodes[failure.GetCode()]
+	if failure.Code == nil || !ok {
+		code = codes.FailedPrecondition
+	}
+
+	return status.Error(code, failure.GetMessage())
+}
+
+// grpcOrigin is the origin of a gRPC call recorded in the audit log
+func grpcOrigin(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "grpc"
+	}
+
+	return "grpc://" + p.Addr.String()
+}
+
+// newAuditTransactionSignMessage returns the audit summary of a TransactionSign message
+func newAuditTransactionSignMessage(req *messages.TransactionSign) auditTransactionSign {
+	summary := auditTransactionSign{
+		Inputs:  make([]string, len(req.GetTransactionIn())),
+		Outputs: make([]auditTransactionOutput, len(req.GetTransactionOut())),
+	}
+
+	for i, in := range req.GetTransactionIn() {
+		summary.Inputs[i] = in.GetHashIn()
+	}
+
+	for i, out := range req.GetTransactionOut() {
+		coins, err := droplet.ToString(out.GetCoin())
+		if err != nil {
+			coins = fmt.Sprintf("%d droplets", out.GetCoin())
+		}
+
+		summary.Outputs[i] = auditTransactionOutput{
+			Address:      out.GetAddress(),
+			AddressIndex: out.AddressIndex,
+			Coins:        coins,
+			Hours:        strconv.FormatUint(out.GetHour(), 10),
+		}
+	}
+
+	return summary
+}
@@ -0,0 +1,252 @@
+package api
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+)
+
+// The types of this file implement the HardwareWallet service of hardware_wallet.proto.
+// They are written the way protoc-gen-go writes them, keep them in sync with the service definition.
+
+const hardwareWalletServiceName = "hardwarewallet.HardwareWallet"
+
+// DeviceMessage is a message of the device. Data is the protobuf encoding of the message named by Kind.
+type DeviceMessage struct {
+	Kind                 *messages.MessageType `protobuf:"varint,1,req,name=kind,enum=MessageType" json:"kind,omitempty"`
+	Data                 []byte                `protobuf:"bytes,2,req,name=data" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+// Reset resets the message
+func (m *DeviceMessage) Reset() { *m = DeviceMessage{} }
+
+// String returns the message in the protobuf text format
+func (m *DeviceMessage) String() string { return proto.CompactTextString(m) }
+
+// ProtoMessage marks DeviceMessage as a protobuf message
+func (*DeviceMessage) ProtoMessage() {}
+
+// GetKind returns the kind of the message
+func (m *DeviceMessage) GetKind() messages.MessageType {
+	if m != nil && m.Kind != nil {
+		return *m.Kind
+	}
+	return messages.MessageType_MessageType_Initialize
+}
+
+// GetData returns the protobuf encoding of the message
+func (m *DeviceMessage) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// HardwareWalletClient is the client API of the HardwareWallet service
+type HardwareWalletClient interface {
+	Features(ctx context.Context, in *messages.GetFeatures, opts ...grpc.CallOption) (*messages.Features, error)
+	AddressGen(ctx context.Context, in *messages.SkycoinAddress, opts ...grpc.CallOption) (DeviceMessageStream, error)
+	SignMessage(ctx context.Context, in *messages.SkycoinSignMessage, opts ...grpc.CallOption) (DeviceMessageStream, error)
+	TransactionSign(ctx context.Context, in *messages.TransactionSign, opts ...grpc.CallOption) (DeviceMessageStream, error)
+	PinMatrixAck(ctx context.Context, in *messages.PinMatrixAck, opts ...grpc.CallOption) (*empty.Empty, error)
+	PassphraseAck(ctx context.Context, in *messages.PassphraseAck, opts ...grpc.CallOption) (*empty.Empty, error)
+	Cancel(ctx context.Context, in *messages.Cancel, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+// DeviceMessageStream receives the device messages of a streaming call
+type DeviceMessageStream interface {
+	Recv() (*DeviceMessage, error)
+	grpc.ClientStream
+}
+
+type hardwareWalletClient struct {
+	cc *grpc.ClientConn
+}
+
+// NewHardwareWalletClient creates a HardwareWalletClient
+func NewHardwareWalletClient(cc *grpc.ClientConn) HardwareWalletClient {
+	return &hardwareWalletClient{cc}
+}
+
+func (c *hardwareWalletClient) invoke(ctx context.Context, method string, in, out interface{}, opts ...grpc.CallOption) error {
+	return c.cc.Invoke(ctx, "/"+hardwareWalletServiceName+"/"+method, in, out, opts...)
+}
+
+func (c *hardwareWalletClient) stream(ctx context.Context, method string, in interface{}, opts ...grpc.CallOption) (DeviceMessageStream, error) {
+	stream, err := c.cc.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, "/"+hardwareWalletServiceName+"/"+method, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	x := &deviceMessageStream{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+func (c *hardwareWalletClient) Features(ctx context.Context, in *messages.GetFeatures, opts ...grpc.CallOption) (*messages.Features, error) {
+	out := new(messages.Features)
+	if err := c.invoke(ctx, "Features", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hardwareWalletClient) AddressGen(ctx context.Context, in *messages.SkycoinAddress, opts ...grpc.CallOption) (DeviceMessageStream, error) {
+	return c.stream(ctx, "AddressGen", in, opts...)
+}
+
+func (c *hardwareWalletClient) SignMessage(ctx context.Context, in *messages.SkycoinSignMessage, opts ...grpc.CallOption) (DeviceMessageStream, error) {
+	return c.stream(ctx, "SignMessage", in, opts...)
+}
+
+func (c *hardwareWalletClient) TransactionSign(ctx context.Context, in *messages.TransactionSign, opts ...grpc.CallOption) (DeviceMessageStream, error) {
+	return c.stream(ctx, "TransactionSign", in, opts...)
+}
+
+func (c *hardwareWalletClient) PinMatrixAck(ctx context.Context, in *messages.PinMatrixAck, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	if err := c.invoke(ctx, "PinMatrixAck", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hardwareWalletClient) PassphraseAck(ctx context.Context, in *messages.PassphraseAck, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	if err := c.invoke(ctx, "PassphraseAck", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hardwareWalletClient) Cancel(ctx context.Context, in *messages.Cancel, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	if err := c.invoke(ctx, "Cancel", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type deviceMessageStream struct {
+	grpc.ClientStream
+}
+
+func (x *deviceMessageStream) Recv() (*DeviceMessage, error) {
+	m := new(DeviceMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// HardwareWalletServer is the server API of the HardwareWallet service
+type HardwareWalletServer interface {
+	Features(context.Context, *messages.GetFeatures) (*messages.Features, error)
+	AddressGen(*messages.SkycoinAddress, DeviceMessageSender) error
+	SignMessage(*messages.SkycoinSignMessage, DeviceMessageSender) error
+	TransactionSign(*messages.TransactionSign, DeviceMessageSender) error
+	PinMatrixAck(context.Context, *messages.PinMatrixAck) (*empty.Empty, error)
+	PassphraseAck(context.Context, *messages.PassphraseAck) (*empty.Empty, error)
+	Cancel(context.Context, *messages.Cancel) (*empty.Empty, error)
+}
+
+// DeviceMessageSender sends the device messages of a streaming call
+type DeviceMessageSender interface {
+	Send(*DeviceMessage) error
+	grpc.ServerStream
+}
+
+// RegisterHardwareWalletServer registers the HardwareWallet service of srv with s
+func RegisterHardwareWalletServer(s *grpc.Server, srv HardwareWalletServer) {
+	s.RegisterService(&hardwareWalletServiceDesc, srv)
+}
+
+type deviceMessageSender struct {
+	grpc.ServerStream
+}
+
+func (x *deviceMessageSender) Send(m *DeviceMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// unaryHandler returns the handler of a unary method, newRequest returns the request message it decodes
+func unaryHandler(method string, newRequest func() interface{}, call func(ctx context.Context, srv HardwareWalletServer, req interface{}) (interface{}, error)) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: method,
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			in := newRequest()
+			if err := dec(in); err != nil {
+				return nil, err
+			}
+			if interceptor == nil {
+				return call(ctx, srv.(HardwareWalletServer), in)
+			}
+			info := &grpc.UnaryServerInfo{
+				Server:     srv,
+				FullMethod: "/" + hardwareWalletServiceName + "/" + method,
+			}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return call(ctx, srv.(HardwareWalletServer), req)
+			}
+			return interceptor(ctx, in, info, handler)
+		},
+	}
+}
+
+// streamHandler returns the handler of a server streaming method, newRequest returns the request message it decodes
+func streamHandler(method string, newRequest func() interface{}, call func(srv HardwareWalletServer, req interface{}, stream DeviceMessageSender) error) grpc.StreamDesc {
+	return grpc.StreamDesc{
+		StreamName: method,
+		Handler: func(srv interface{}, stream grpc.ServerStream) error {
+			in := newRequest()
+			if err := stream.RecvMsg(in); err != nil {
+				return err
+			}
+			return call(srv.(HardwareWalletServer), in, &deviceMessageSender{stream})
+		},
+		ServerStreams: true,
+	}
+}
+
+var hardwareWalletServiceDesc = grpc.ServiceDesc{
+	ServiceName: hardwareWalletServiceName,
+	HandlerType: (*HardwareWalletServer)(nil),
+	Methods: []grpc.MethodDesc{
+		unaryHandler("Features", func() interface{} { return new(messages.GetFeatures) }, func(ctx context.Context, srv HardwareWalletServer, req interface{}) (interface{}, error) {
+			return srv.Features(ctx, req.(*messages.GetFeatures))
+		}),
+		unaryHandler("PinMatrixAck", func() interface{} { return new(messages.PinMatrixAck) }, func(ctx context.Context, srv HardwareWalletServer, req interface{}) (interface{}, error) {
+			return srv.PinMatrixAck(ctx, req.(*messages.PinMatrixAck))
+		}),
+		unaryHandler("PassphraseAck", func() interface{} { return new(messages.PassphraseAck) }, func(ctx context.Context, srv HardwareWalletServer, req interface{}) (interface{}, error) {
+			return srv.PassphraseAck(ctx, req.(*messages.PassphraseAck))
+		}),
+		unaryHandler("Cancel", func() interface{} { return new(messages.Cancel) }, func(ctx context.Context, srv HardwareWalletServer, req interface{}) (interface{}, error) {
+			return srv.Cancel(ctx, req.(*messages.Cancel))
+		}),
+	},
+	Streams: []grpc.StreamDesc{
+		streamHandler("AddressGen", func() interface{} { return new(messages.SkycoinAddress) }, func(srv HardwareWalletServer, req interface{}, stream DeviceMessageSender) error {
+			return srv.AddressGen(req.(*messages.SkycoinAddress), stream)
+		}),
+		streamHandler("SignMessage", func() interface{} { return new(messages.SkycoinSignMessage) }, func(srv HardwareWalletServer, req interface{}, stream DeviceMessageSender) error {
+			return srv.SignMessage(req.(*messages.SkycoinSignMessage), stream)
+		}),
+		streamHandler("TransactionSign", func() interface{} { return new(messages.TransactionSign) }, func(srv HardwareWalletServer, req interface{}, stream DeviceMessageSender) error {
+			return srv.TransactionSign(req.(*messages.TransactionSign), stream)
+		}),
+	},
+	Metadata: "hardware_wallet.proto",
+}
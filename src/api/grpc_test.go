@@ -0,0 +1,446 @@
+package api
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+)
+
+// newTestGRPCClient serves the gRPC service of a server with gateway and returns a client of it
+func newTestGRPCClient(t *testing.T, gateway Gatewayer, auditLog *AuditLog, maxAddressIndex uint32) (HardwareWalletClient, func()) {
+	s, err := CreateGRPC("127.0.0.1:0", &Server{
+		gateway:         gateway,
+		auditLog:        auditLog,
+		maxAddressIndex: maxAddressIndex,
+	})
+	require.NoError(t, err)
+
+	go func() {
+		require.NoError(t, s.Serve())
+	}()
+
+	conn, err := grpc.Dial(s.Addr(), grpc.WithInsecure())
+	require.NoError(t, err)
+
+	return NewHardwareWalletClient(conn), func() {
+		conn.Close()
+		s.Shutdown()
+	}
+}
+
+// recvDeviceMessages receives the messages of a stream until it ends, n is the number of messages to receive before the end
+func recvDeviceMessages(t *testing.T, stream DeviceMessageStream, n int) []*DeviceMessage {
+	var msgs []*DeviceMessage
+	for i := 0; i < n; i++ {
+		msg, err := stream.Recv()
+		require.NoError(t, err)
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
+
+func requireStatus(t *testing.T, code codes.Code, message string, err error) {
+	require.Error(t, err)
+	require.Equal(t, code, status.Code(err), err.Error())
+	require.Equal(t, message, status.Convert(err).Message())
+}
+
+func testTransactionSignMessage() *messages.TransactionSign {
+	return &messages.TransactionSign{
+		NbIn: proto.Uint32(1),
+		TransactionIn: []*messages.SkycoinTransactionInput{
+			{
+				HashIn: proto.String("181bd5656115172fe81451fae4fb56498a97744d89702e73da75ba91ed5200f9"),
+				Index:  proto.Uint32(0),
+			},
+		},
+		NbOut: proto.Uint32(1),
+		TransactionOut: []*messages.SkycoinTransactionOutput{
+			{
+				Address: proto.String("K9TzLrgqz7uXn3QJHGxmzdRByAzH33J2ot"),
+				Coin:    proto.Uint64(1500000),
+				Hour:    proto.Uint64(2),
+			},
+		},
+	}
+}
+
+func testTransactionSignResponseMessage(t *testing.T) wire.Message {
+	rsp := messages.ResponseTransactionSign{
+		Signatures: []string{"signature"},
+		Padding:    newBoolPtr(false),
+	}
+
+	data, err := rsp.Marshal()
+	require.NoError(t, err)
+
+	return wire.Message{
+		Kind: uint16(messages.MessageType_MessageType_ResponseTransactionSign),
+		Data: data,
+	}
+}
+
+func TestGRPCFeatures(t *testing.T) {
+	cases := []struct {
+		name     string
+		msg      wire.Message
+		err      error
+		code     codes.Code
+		message  string
+		deviceID string
+	}{
+		{
+			name:     "features",
+			msg:      testFeaturesMessage(t, "device-id", false),
+			deviceID: "device-id",
+		},
+		{
+			name:    "failure",
+			msg:     testFailureCodeMessage(t, messages.FailureType_Failure_NotInitialized, "Device not initialized"),
+			code:    codes.FailedPrecondition,
+			message: "Device not initialized",
+		},
+		{
+			name:    "gateway error",
+			err:     ErrNotConnected,
+			code:    codes.Internal,
+			message: ErrNotConnected.Error(),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+			gateway.On("GetFeatures").Return(tc.msg, tc.err)
+
+			client, done := newTestGRPCClient(t, gateway, nil, DefaultMaxAddressIndex)
+			defer done()
+
+			features, err := client.Features(context.Background(), &messages.GetFeatures{})
+			if tc.code != codes.OK {
+				requireStatus(t, tc.code, tc.message, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.deviceID, features.GetDeviceId())
+		})
+	}
+}
+
+func TestGRPCAddressGen(t *testing.T) {
+	cases := []struct {
+		name     string
+		req      *messages.SkycoinAddress
+		batches  [][2]uint32
+		code     codes.Code
+		message  string
+		messages int
+	}{
+		{
+			name:    "address_n 0",
+			req:     &messages.SkycoinAddress{AddressN: proto.Uint32(0)},
+			code:    codes.InvalidArgument,
+			message: "address_n cannot be 0",
+		},
+		{
+			name: "max address index",
+			req: &messages.SkycoinAddress{
+				AddressN:   proto.Uint32(2),
+				StartIndex: proto.Uint32(199),
+			},
+			code:    codes.OutOfRange,
+			message: "addresses above index 199 cannot be generated",
+		},
+		{
+			name: "confirm_address in batches",
+			req: &messages.SkycoinAddress{
+				AddressN:       proto.Uint32(maxAddressN + 1),
+				ConfirmAddress: proto.Bool(true),
+			},
+			code:    codes.InvalidArgument,
+			message: "confirm_address cannot be used with more than 99 addresses",
+		},
+		{
+			name: "one batch",
+			req: &messages.SkycoinAddress{
+				AddressN:   proto.Uint32(2),
+				StartIndex: proto.Uint32(3),
+			},
+			batches:  [][2]uint32{{2, 3}},
+			messages: 1,
+		},
+		{
+			name: "batches",
+			req: &messages.SkycoinAddress{
+				AddressN:   proto.Uint32(150),
+				StartIndex: proto.Uint32(10),
+			},
+			batches:  [][2]uint32{{99, 10}, {51, 109}},
+			messages: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+			for _, b := range tc.batches {
+				gateway.On("AddressGen", b[0], b[1], false).Return(testAddressesMessage(t, "2EU3JbveHdkxW6z5tdhbbB2kRAWvXC2pLzw"), nil).Once()
+			}
+
+			client, done := newTestGRPCClient(t, gateway, nil, 199)
+			defer done()
+
+			stream, err := client.AddressGen(context.Background(), tc.req)
+			require.NoError(t, err)
+
+			msgs := recvDeviceMessages(t, stream, tc.messages)
+			for _, msg := range msgs {
+				require.Equal(t, messages.MessageType_MessageType_ResponseSkycoinAddress, msg.GetKind())
+
+				var rsp messages.ResponseSkycoinAddress
+				require.NoError(t, proto.Unmarshal(msg.GetData(), &rsp))
+				require.Equal(t, []string{"2EU3JbveHdkxW6z5tdhbbB2kRAWvXC2pLzw"}, rsp.Addresses)
+			}
+
+			_, err = stream.Recv()
+			if tc.code != codes.OK {
+				requireStatus(t, tc.code, tc.message, err)
+			} else {
+				require.Equal(t, io.EOF, err)
+			}
+
+			gateway.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGRPCTransactionSign(t *testing.T) {
+	t.Run("invalid request", func(t *testing.T) {
+		gateway := &MockGatewayer{}
+		client, done := newTestGRPCClient(t, gateway, nil, DefaultMaxAddressIndex)
+		defer done()
+
+		noInputs := testTransactionSignMessage()
+		noInputs.TransactionIn = nil
+
+		stream, err := client.TransactionSign(context.Background(), noInputs)
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		requireStatus(t, codes.InvalidArgument, "inputs are required", err)
+
+		badAddress := testTransactionSignMessage()
+		badAddress.TransactionOut[0].Address = proto.String("foo")
+
+		stream, err = client.TransactionSign(context.Background(), badAddress)
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		requireStatus(t, codes.InvalidArgument, "Invalid address length", err)
+	})
+
+	t.Run("signed after button", func(t *testing.T) {
+		auditLog, cleanup := newTestAuditLog(t)
+		defer cleanup()
+
+		gateway := &MockGatewayer{}
+		gateway.On("GetFeatures").Return(testFeaturesMessage(t, "device-id", false), nil)
+		gateway.On("TransactionSign", mock.Anything, mock.Anything).Return(testButtonRequestMessage(t, messages.ButtonRequestType_ButtonRequest_SignTx), nil)
+		gateway.On("ButtonAck").Return(testTransactionSignResponseMessage(t), nil)
+
+		client, done := newTestGRPCClient(t, gateway, auditLog, DefaultMaxAddressIndex)
+		defer done()
+
+		stream, err := client.TransactionSign(context.Background(), testTransactionSignMessage())
+		require.NoError(t, err)
+
+		msgs := recvDeviceMessages(t, stream, 2)
+		require.Equal(t, messages.MessageType_MessageType_ButtonRequest, msgs[0].GetKind())
+		require.Equal(t, messages.MessageType_MessageType_ResponseTransactionSign, msgs[1].GetKind())
+
+		var rsp messages.ResponseTransactionSign
+		require.NoError(t, proto.Unmarshal(msgs[1].GetData(), &rsp))
+		require.Equal(t, []string{"signature"}, rsp.Signatures)
+
+		_, err = stream.Recv()
+		require.Equal(t, io.EOF, err)
+
+		entries, err := auditLog.Entries(AuditFilter{})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, "transaction_sign", entries[0].Operation)
+		require.Equal(t, "ResponseTransactionSign", entries[0].Result)
+		require.Equal(t, "device-id", entries[0].DeviceID)
+		require.True(t, strings.HasPrefix(entries[0].Origin, "grpc://127.0.0.1:"), entries[0].Origin)
+		require.JSONEq(t, `{"inputs":["181bd5656115172fe81451fae4fb56498a97744d89702e73da75ba91ed5200f9"],"outputs":[{"address":"K9TzLrgqz7uXn3QJHGxmzdRByAzH33J2ot","coins":"1.500000","hours":"2"}]}`, string(entries[0].Request))
+	})
+
+	t.Run("signed after pin", func(t *testing.T) {
+		gateway := &MockGatewayer{}
+		gateway.On("TransactionSign", mock.Anything, mock.Anything).Return(testPinMatrixRequestMessage(t, messages.PinMatrixRequestType_PinMatrixRequestType_Current), nil)
+		gateway.On("PinMatrixAck", "1234").Return(testTransactionSignResponseMessage(t), nil)
+
+		client, done := newTestGRPCClient(t, gateway, nil, DefaultMaxAddressIndex)
+		defer done()
+
+		stream, err := client.TransactionSign(context.Background(), testTransactionSignMessage())
+		require.NoError(t, err)
+
+		msgs := recvDeviceMessages(t, stream, 1)
+		require.Equal(t, messages.MessageType_MessageType_PinMatrixRequest, msgs[0].GetKind())
+
+		// the device is busy until the pin code is entered
+		_, err = client.Features(context.Background(), &messages.GetFeatures{})
+		requireStatus(t, codes.Unavailable, "another device operation is in progress", err)
+
+		_, err = client.PinMatrixAck(context.Background(), &messages.PinMatrixAck{Pin: proto.String("1234")})
+		require.NoError(t, err)
+
+		msgs = recvDeviceMessages(t, stream, 1)
+		require.Equal(t, messages.MessageType_MessageType_ResponseTransactionSign, msgs[0].GetKind())
+
+		_, err = stream.Recv()
+		require.Equal(t, io.EOF, err)
+
+		// the pin code was already entered
+		_, err = client.PinMatrixAck(context.Background(), &messages.PinMatrixAck{Pin: proto.String("1234")})
+		requireStatus(t, codes.FailedPrecondition, "no device operation is waiting for a MessageType_PinMatrixRequest", err)
+	})
+
+	t.Run("wrong pin", func(t *testing.T) {
+		auditLog, cleanup := newTestAuditLog(t)
+		defer cleanup()
+
+		gateway := &MockGatewayer{}
+		gateway.On("GetFeatures").Return(testFeaturesMessage(t, "device-id", false), nil)
+		gateway.On("TransactionSign", mock.Anything, mock.Anything).Return(testPinMatrixRequestMessage(t, messages.PinMatrixRequestType_PinMatrixRequestType_Current), nil)
+		gateway.On("PinMatrixAck", "1111").Return(testFailureCodeMessage(t, messages.FailureType_Failure_PinInvalid, "PIN invalid"), nil)
+
+		client, done := newTestGRPCClient(t, gateway, auditLog, DefaultMaxAddressIndex)
+		defer done()
+
+		stream, err := client.TransactionSign(context.Background(), testTransactionSignMessage())
+		require.NoError(t, err)
+		recvDeviceMessages(t, stream, 1)
+
+		_, err = client.PinMatrixAck(context.Background(), &messages.PinMatrixAck{Pin: proto.String("1111")})
+		require.NoError(t, err)
+
+		msgs := recvDeviceMessages(t, stream, 1)
+		require.Equal(t, messages.MessageType_MessageType_Failure, msgs[0].GetKind())
+
+		_, err = stream.Recv()
+		requireStatus(t, codes.PermissionDenied, "PIN invalid", err)
+
+		entries, err := auditLog.Entries(AuditFilter{})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, "Failure", entries[0].Result)
+		require.Equal(t, "PIN invalid", entries[0].Message)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		gateway := &MockGatewayer{}
+		gateway.On("TransactionSign", mock.Anything, mock.Anything).Return(testPinMatrixRequestMessage(t, messages.PinMatrixRequestType_PinMatrixRequestType_Current), nil)
+		gateway.On("Cancel").Return(testFailureMessage(t, "Action cancelled by user"), nil)
+
+		client, done := newTestGRPCClient(t, gateway, nil, DefaultMaxAddressIndex)
+		defer done()
+
+		stream, err := client.TransactionSign(context.Background(), testTransactionSignMessage())
+		require.NoError(t, err)
+		recvDeviceMessages(t, stream, 1)
+
+		_, err = client.Cancel(context.Background(), &messages.Cancel{})
+		require.NoError(t, err)
+
+		msgs := recvDeviceMessages(t, stream, 1)
+		require.Equal(t, messages.MessageType_MessageType_Failure, msgs[0].GetKind())
+
+		_, err = stream.Recv()
+		requireStatus(t, codes.Canceled, "Action cancelled by user", err)
+	})
+
+	t.Run("client gone", func(t *testing.T) {
+		cancelled := make(chan struct{})
+
+		gateway := &MockGatewayer{}
+		gateway.On("TransactionSign", mock.Anything, mock.Anything).Return(testPinMatrixRequestMessage(t, messages.PinMatrixRequestType_PinMatrixRequestType_Current), nil)
+		gateway.On("Cancel").Return(testFailureMessage(t, "Action cancelled by user"), nil).Run(func(args mock.Arguments) {
+			close(cancelled)
+		})
+
+		client, done := newTestGRPCClient(t, gateway, nil, DefaultMaxAddressIndex)
+		defer done()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		stream, err := client.TransactionSign(ctx, testTransactionSignMessage())
+		require.NoError(t, err)
+		recvDeviceMessages(t, stream, 1)
+
+		cancel()
+
+		// the device does not keep waiting for the pin code
+		select {
+		case <-cancelled:
+		case <-time.After(5 * time.Second):
+			t.Fatal("the device operation was not cancelled")
+		}
+	})
+}
+
+func TestGRPCSignMessage(t *testing.T) {
+	gateway := &MockGatewayer{}
+	gateway.On("SignMessage", 2, "foo").Return(wire.Message{
+		Kind: uint16(messages.MessageType_MessageType_PassphraseRequest),
+	}, nil)
+	gateway.On("PassphraseAck", "secret").Return(testSignatureMessage(t, "signature"), nil)
+
+	client, done := newTestGRPCClient(t, gateway, nil, DefaultMaxAddressIndex)
+	defer done()
+
+	stream, err := client.SignMessage(context.Background(), &messages.SkycoinSignMessage{
+		AddressN: proto.Uint32(2),
+		Message:  proto.String(""),
+	})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	requireStatus(t, codes.InvalidArgument, "message is required", err)
+
+	stream, err = client.SignMessage(context.Background(), &messages.SkycoinSignMessage{
+		AddressN: proto.Uint32(2),
+		Message:  proto.String("foo"),
+	})
+	require.NoError(t, err)
+
+	msgs := recvDeviceMessages(t, stream, 1)
+	require.Equal(t, messages.MessageType_MessageType_PassphraseRequest, msgs[0].GetKind())
+
+	// a pin code is not what the device waits for
+	_, err = client.PinMatrixAck(context.Background(), &messages.PinMatrixAck{Pin: proto.String("1234")})
+	requireStatus(t, codes.FailedPrecondition, "no device operation is waiting for a MessageType_PinMatrixRequest", err)
+
+	_, err = client.PassphraseAck(context.Background(), &messages.PassphraseAck{Passphrase: proto.String("secret")})
+	require.NoError(t, err)
+
+	msgs = recvDeviceMessages(t, stream, 1)
+	require.Equal(t, messages.MessageType_MessageType_ResponseSkycoinSignMessage, msgs[0].GetKind())
+
+	var rsp messages.ResponseSkycoinSignMessage
+	require.NoError(t, proto.Unmarshal(msgs[0].GetData(), &rsp))
+	require.Equal(t, "signature", rsp.GetSignedMessage())
+
+	_, err = stream.Recv()
+	require.Equal(t, io.EOF, err)
+}
@@ -16,20 +16,33 @@ import (
 type HTTPResponse struct {
 	Error *HTTPError  `json:"error,omitempty"`
 	Data  interface{} `json:"data,omitempty"`
+	// Intermediate is set when the device is waiting for user input
+	Intermediate *IntermediateResponse `json:"intermediate,omitempty"`
 }
 
 // ReceivedHTTPResponse parsed is a Parsed HTTPResponse
 type ReceivedHTTPResponse struct {
-	Error *HTTPError      `json:"error,omitempty"`
-	Data  json.RawMessage `json:"data"`
+	Error        *HTTPError            `json:"error,omitempty"`
+	Data         json.RawMessage       `json:"data"`
+	Intermediate *IntermediateResponse `json:"intermediate,omitempty"`
 }
 
 // HTTPError is included in an HTTPResponse
 type HTTPError struct {
 	Message string `json:"message"`
 	Code    int    `json:"code"`
+	// Type identifies the error for clients which handle it, it is set for firmware
+	// Failure messages and some other errors only
+	Type string `json:"type,omitempty"`
 }
 
+// Types of HTTPError which are not mapped from a firmware Failure
+const (
+	// ErrorTypeUnsupportedFirmwareRequest is the type of the error returned when
+	// the firmware sends a request which the daemon can't answer
+	ErrorTypeUnsupportedFirmwareRequest = "unsupported_firmware_request"
+)
+
 // NewHTTPErrorResponse returns an HTTPResponse with the Error field populated
 func NewHTTPErrorResponse(code int, msg string) HTTPResponse {
 	if msg == "" {
@@ -69,34 +82,28 @@ func writeHTTPResponse(w http.ResponseWriter, resp HTTPResponse) {
 	}
 }
 
+// writeNDJSON writes v as a line of a newline delimited json stream
+func writeNDJSON(w http.ResponseWriter, v interface{}) {
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logger.WithError(err).Error("http Write failed")
+	}
+}
+
 // HandleFirmwareResponseMessages handles response messages from the firmware
 func HandleFirmwareResponseMessages(w http.ResponseWriter, msg wire.Message) {
 	switch msg.Kind {
 	case uint16(messages.MessageType_MessageType_PinMatrixRequest):
-		writeHTTPResponse(w, HTTPResponse{
-			Data: []string{"PinMatrixRequest"},
-		})
+		writeIntermediateResponse(w, msg, "PinMatrixRequest")
 	case uint16(messages.MessageType_MessageType_PassphraseRequest):
-		writeHTTPResponse(w, HTTPResponse{
-			Data: []string{"PassPhraseRequest"},
-		})
+		writeIntermediateResponse(w, msg, "PassPhraseRequest")
 	case uint16(messages.MessageType_MessageType_WordRequest):
-		writeHTTPResponse(w, HTTPResponse{
-			Data: []string{"WordRequest"},
-		})
+		writeIntermediateResponse(w, msg, "WordRequest")
 	case uint16(messages.MessageType_MessageType_ButtonRequest):
-		writeHTTPResponse(w, HTTPResponse{
-			Data: []string{"ButtonRequest"},
-		})
+		writeIntermediateResponse(w, msg, "ButtonRequest")
+	case uint16(messages.MessageType_MessageType_PassphraseStateRequest):
+		writeIntermediateResponse(w, msg, "PassphraseStateRequest")
 	case uint16(messages.MessageType_MessageType_Failure):
-		failureMsg, err := skyWallet.DecodeFailMsg(msg)
-		if err != nil {
-			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
-			writeHTTPResponse(w, resp)
-			return
-		}
-		resp := NewHTTPErrorResponse(http.StatusConflict, failureMsg)
-		writeHTTPResponse(w, resp)
+		writeHTTPResponse(w, newFailureResponse(msg))
 	case uint16(messages.MessageType_MessageType_Success):
 		successMsg, err := skyWallet.DecodeSuccessMsg(msg)
 		if err != nil {
@@ -158,11 +165,37 @@ func HandleFirmwareResponseMessages(w http.ResponseWriter, msg wire.Message) {
 			Data: &signatures,
 		})
 	default:
-		resp := NewHTTPErrorResponse(http.StatusInternalServerError, fmt.Sprintf("recevied unexpected response message type: %s", messages.MessageType(msg.Kind)))
-		writeHTTPResponse(w, resp)
+		writeHTTPResponse(w, newUnsupportedFirmwareRequestResponse(msg))
 	}
 }
 
+// newUnsupportedFirmwareRequestResponse returns the error response of a firmware message
+// which the daemon does not know how to handle
+func newUnsupportedFirmwareRequestResponse(msg wire.Message) HTTPResponse {
+	resp := NewHTTPErrorResponse(http.StatusNotImplemented, fmt.Sprintf("unsupported firmware request: %s", messages.MessageType(msg.Kind)))
+	resp.Error.Type = ErrorTypeUnsupportedFirmwareRequest
+	return resp
+}
+
+// deviceFeatures requests and decodes the device features
+func deviceFeatures(gateway Gatewayer) (*messages.Features, error) {
+	msg, err := gateway.GetFeatures()
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Kind != uint16(messages.MessageType_MessageType_Features) {
+		return nil, fmt.Errorf("received unexpected response message type: %s", messages.MessageType(msg.Kind))
+	}
+
+	features := &messages.Features{}
+	if err := proto.Unmarshal(msg.Data, features); err != nil {
+		return nil, err
+	}
+
+	return features, nil
+}
+
 func newStrPtr(s string) *string {
 	return &s
 }
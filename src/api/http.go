@@ -5,6 +5,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
 	"regexp"
 	"runtime"
 
@@ -20,8 +21,18 @@ const (
 	ContentTypeJSON = "application/json"
 	// ContentTypeForm form data content type header
 	ContentTypeForm = "application/x-www-form-urlencoded"
+	// ContentTypeNDJSON newline delimited json content type header
+	ContentTypeNDJSON = "application/x-ndjson"
+	// ContentTypeOctetStream binary data content type header
+	ContentTypeOctetStream = "application/octet-stream"
+
+	// DefaultMaxAddressIndex is the default highest address index which can be generated
+	DefaultMaxAddressIndex = 10000
+	// DefaultMaxEntropyBytes is the default maximum number of entropy bytes which can be downloaded at once
+	DefaultMaxEntropyBytes = 10 * 1024 * 1024
 
 	apiVersion1 = "v1"
+	apiVersion2 = "v2"
 )
 
 var (
@@ -61,6 +72,20 @@ type Config struct {
 	HostWhitelist      []string
 	Mode               skyWallet.DeviceType
 	Build              BuildInfo
+	// DataDirectory holds the audit log and the address book. Both are disabled if empty.
+	DataDirectory string
+	// NodeAddress is the REST API address of the skycoin node used for account discovery.
+	// Account discovery is disabled if empty.
+	NodeAddress string
+	// MaxAddressIndex is the highest address index which can be generated.
+	// DefaultMaxAddressIndex is used if 0.
+	MaxAddressIndex uint32
+	// AddressLookupLimit is the number of addresses scanned to find the index of an address.
+	// DefaultAddressLookupLimit is used if 0.
+	AddressLookupLimit uint32
+	// MaxEntropyBytes is the maximum number of entropy bytes which can be downloaded at once.
+	// DefaultMaxEntropyBytes is used if 0.
+	MaxEntropyBytes uint32
 }
 
 type muxConfig struct {
@@ -70,6 +95,12 @@ type muxConfig struct {
 	hostWhitelist      []string
 	mode               skyWallet.DeviceType
 	build              BuildInfo
+	auditLog           *AuditLog
+	addressBook        *AddressBook
+	node               *NodeClient
+	maxAddressIndex    uint32
+	addressLookupLimit uint32
+	maxEntropyBytes    uint32
 }
 
 // Server exposes an HTTP API
@@ -77,6 +108,12 @@ type Server struct {
 	server   *http.Server
 	listener net.Listener
 	done     chan struct{}
+
+	// the device gateway and the state shared with the gRPC service
+	gateway         Gatewayer
+	auditLog        *AuditLog
+	addressBook     *AddressBook
+	maxAddressIndex uint32
 }
 
 // Serve serves the web interface on the configured host
@@ -113,17 +150,45 @@ func create(host string, c Config, gateway *Gateway) *Server {
 		hostWhitelist:      c.HostWhitelist,
 		mode:               c.Mode,
 		build:              c.Build,
+		maxAddressIndex:    c.MaxAddressIndex,
+		addressLookupLimit: c.AddressLookupLimit,
+		maxEntropyBytes:    c.MaxEntropyBytes,
+	}
+
+	if mc.maxAddressIndex == 0 {
+		mc.maxAddressIndex = DefaultMaxAddressIndex
 	}
 
-	srvMux := newServerMux(mc, gateway.Device)
+	if mc.addressLookupLimit == 0 {
+		mc.addressLookupLimit = DefaultAddressLookupLimit
+	}
+
+	if mc.maxEntropyBytes == 0 {
+		mc.maxEntropyBytes = DefaultMaxEntropyBytes
+	}
+
+	if c.DataDirectory != "" {
+		mc.auditLog = NewAuditLog(filepath.Join(c.DataDirectory, AuditLogFilename))
+		mc.addressBook = NewAddressBook(filepath.Join(c.DataDirectory, AddressBookDirname))
+	}
+
+	if c.NodeAddress != "" {
+		mc.node = NewNodeClient(c.NodeAddress)
+	}
+
+	srvMux := newServerMux(mc, gateway)
 
 	srv := &http.Server{
 		Handler: srvMux,
 	}
 
 	return &Server{
-		server: srv,
-		done:   make(chan struct{}),
+		server:          srv,
+		done:            make(chan struct{}),
+		gateway:         gateway,
+		auditLog:        mc.auditLog,
+		addressBook:     mc.addressBook,
+		maxAddressIndex: mc.maxAddressIndex,
 	}
 }
 
@@ -187,8 +252,8 @@ func newServerMux(c muxConfig, gateway Gatewayer) *http.ServeMux {
 		return handler
 	}
 
-	webHandlerWithOptionals := func(endpoint string, handlerFunc http.Handler, checkCSRF, checkHeaders bool) {
-		handler := wh.ElapsedHandler(logger, handlerFunc)
+	checkedHandler := func(handlerFunc http.Handler, checkCSRF, checkHeaders bool) http.Handler {
+		handler := withFlusher(wh.ElapsedHandler(logger, handlerFunc))
 
 		handler = corsHandler.Handler(handler)
 
@@ -200,6 +265,12 @@ func newServerMux(c muxConfig, gateway Gatewayer) *http.ServeMux {
 			handler = headerCheck(c.host, c.hostWhitelist, handler)
 		}
 
+		return handler
+	}
+
+	webHandlerWithOptionals := func(endpoint string, handlerFunc http.Handler, checkCSRF, checkHeaders bool) {
+		handler := checkedHandler(handlerFunc, checkCSRF, checkHeaders)
+
 		handler = gziphandler.GzipHandler(handler)
 
 		mux.Handle(endpoint, handler)
@@ -209,8 +280,10 @@ func newServerMux(c muxConfig, gateway Gatewayer) *http.ServeMux {
 		webHandlerWithOptionals(endpoint, handler, c.enableCSRF, !c.disableHeaderCheck)
 	}
 
+	// the flows of an endpoint which ask for user input are tracked as the endpoint operation
+	operations := &operationTracker{}
 	webHandlerV1 := func(endpoint string, handler http.Handler) {
-		webHandler("/api/"+apiVersion1+endpoint, handler)
+		webHandler("/api/"+apiVersion1+endpoint, trackOperation(endpoint, operations, validateRequestBody(endpoint, handler)))
 	}
 
 	if autoPressEmulatorButtons && c.mode != skyWallet.DeviceTypeEmulator {
@@ -223,31 +296,87 @@ func newServerMux(c muxConfig, gateway Gatewayer) *http.ServeMux {
 	}
 	csrfHandlerV1("/csrf", getCSRFToken(c.enableCSRF)) // csrf is always available, regardless of the API set
 
+	signatures := newSignatureCheck(c.build.Version)
+	backups := newBackupVerification()
+	provisions := newProvisioning(c.auditLog, c.addressBook)
+	discoveries := newAccountDiscoveries()
+	verifications := newAddressVerifications(c.addressBook)
+	lookup := newAddressLookup(gateway, c.addressBook, c.addressLookupLimit)
+	generations := newAddressGenerations(c.addressBook)
+	signings := newMessageSignings(c.auditLog)
+
+	// the flows waiting for user input are resumed in this order until one of them writes the response
+	flows := newDeviceFlows(c.auditLog, c.addressBook, backups, provisions, signatures, discoveries, verifications, lookup, generations, signings)
+
+	// endpoints which start a new device flow interrupt any tracked flow waiting for user input
+	flowHandlerV1 := func(endpoint string, handler http.Handler) {
+		webHandlerV1(endpoint, flows.interrupting(handler))
+	}
+
 	// hw daemon endpoints
-	webHandlerV1("/generate_addresses", generateAddresses(gateway))
-	webHandlerV1("/apply_settings", applySettings(gateway))
-	webHandlerV1("/backup", backup(gateway))
-	webHandlerV1("/cancel", cancel(gateway))
-	webHandlerV1("/check_message_signature", checkMessageSignature(gateway))
-	webHandlerV1("/features", features(gateway))
+	flowHandlerV1("/generate_addresses", generateAddresses(gateway, c.addressBook, c.maxAddressIndex, generations))
+	flowHandlerV1("/apply_settings", applySettings(gateway))
+	flowHandlerV1("/backup", backup(gateway))
+	// reading the status of the last verification does not use the device, so it only interrupts tracked flows when a verification starts
+	webHandlerV1("/backup/verify", backupVerify(gateway, backups, flows))
+	webHandlerV1("/cancel", cancel(gateway, flows))
+	// offline signature checks do not use the device, so they only interrupt tracked flows when they start a device flow
+	webHandlerV1("/check_message_signature", checkMessageSignature(gateway, flows))
+	webHandlerV1("/check_message_signature/armored", checkArmoredMessageSignature(gateway, flows))
+	flowHandlerV1("/features", features(gateway))
 	// enable firmware update endpoint only for hw wallet
 	if c.mode == skyWallet.DeviceTypeUSB {
-		webHandlerV1("/firmware_update", firmwareUpdate(gateway))
+		flowHandlerV1("/firmware_update", firmwareUpdate(gateway, c.auditLog))
 		webHandlerV1("/available", available(gateway))
 	}
-	webHandlerV1("/generate_mnemonic", generateMnemonic(gateway))
-	webHandlerV1("/recovery", recovery(gateway))
-	webHandlerV1("/set_mnemonic", setMnemonic(gateway))
-	webHandlerV1("/configure_pin_code", configurePinCode(gateway))
-	webHandlerV1("/sign_message", signMessage(gateway))
-	webHandlerV1("/transaction_sign", transactionSign(gateway))
-	webHandlerV1("/wipe", wipe(gateway))
+	flowHandlerV1("/generate_mnemonic", generateMnemonic(gateway, c.addressBook))
+	flowHandlerV1("/recovery", recovery(gateway, c.addressBook))
+	webHandlerV1("/mnemonic/validate", mnemonicValidate())
+	webHandlerV1("/mnemonic/words", mnemonicWordsHandler())
+	flowHandlerV1("/set_mnemonic", setMnemonic(gateway, c.auditLog, c.addressBook))
+	flowHandlerV1("/configure_pin_code", configurePinCode(gateway))
+	flowHandlerV1("/sign_message", lookup.resumable(signMessage(gateway, c.auditLog, lookup, signatures)))
+	flowHandlerV1("/sign_messages", lookup.resumable(signMessagesHandler(gateway, c.auditLog, lookup, signings)))
+	flowHandlerV1("/transaction_sign", lookup.resumable(transactionSign(gateway, c.auditLog, lookup)))
+	flowHandlerV1("/wipe", wipe(gateway, c.auditLog, c.addressBook))
+	// reading the status of the last provisioning does not use the device, so it only interrupts tracked flows when the provisioning runs
+	webHandlerV1("/provision", provision(gateway, provisions, flows))
+	webHandlerV1("/provision/resume", provisionResume(gateway, provisions, flows))
+	// load the emulator in one request for integration test fixtures
+	if c.mode == skyWallet.DeviceTypeEmulator {
+		flowHandlerV1("/emulator/load_device", loadDevice(gateway, c.auditLog, c.addressBook))
+	}
 
-	webHandlerV1("/intermediate/pin_matrix", pinMatrixRequestHandler(gateway))
-	webHandlerV1("/intermediate/passphrase", passphraseRequestHandler(gateway))
-	webHandlerV1("/intermediate/word", wordRequestHandler(gateway))
-	webHandlerV1("/intermediate/button", buttonRequestHandler(gateway))
+	// intermediate endpoints continue the operation which asked for user input
+	intermediateHandlerV1 := func(endpoint string, handler http.Handler) {
+		webHandler("/api/"+apiVersion1+endpoint, continueOperation(operations, validateRequestBody(endpoint, handler)))
+	}
+	intermediateHandlerV1("/intermediate/pin_matrix", pinMatrixRequestHandler(gateway, flows))
+	intermediateHandlerV1("/intermediate/passphrase", passphraseRequestHandler(gateway, c.addressBook, flows))
+	intermediateHandlerV1("/intermediate/word", wordRequestHandler(gateway, backups, flows))
+	intermediateHandlerV1("/intermediate/button", buttonRequestHandler(gateway, flows))
+	intermediateHandlerV1("/intermediate/passphrase_state", passphraseStateRequestHandler(gateway, flows))
+
+	webHandlerV1("/audit", auditHandler(c.auditLog))
+	webHandlerV1("/addresses", addressBookHandler(gateway, c.addressBook))
+	flowHandlerV1("/addresses/", addressQR(gateway, c.addressBook, c.maxAddressIndex))
+	flowHandlerV1("/account_discovery", accountDiscoveryHandler(gateway, c.node, c.addressBook, c.maxAddressIndex, discoveries))
+	flowHandlerV1("/verify_address", verifyAddressHandler(gateway, c.addressBook, verifications))
+	flowHandlerV1("/address_index", lookup.resumable(addressIndex(gateway, lookup)))
+	flowHandlerV1("/entropy/raw", entropy(gateway, skyWallet.MessageDeviceGetRawEntropy, c.maxEntropyBytes))
+	flowHandlerV1("/entropy/mixed", entropy(gateway, skyWallet.MessageDeviceGetMixedEntropy, c.maxEntropyBytes))
+	flowHandlerV1("/entropy/report", entropyReport(gateway, c.maxEntropyBytes))
 
 	webHandlerV1("/version", versionHandler(c))
+
+	webHandlerV1("/openapi.json", openAPIHandler())
+
+	// json-rpc serves the Gatewayer operations with the v1 handlers
+	webHandler("/api/"+apiVersion1+"/rpc", rpcHandler(mux))
+
+	// api v2 serves the device resources with the v1 handlers, in a single error envelope
+	handlerV2 := checkedHandler(newV2Handler(mux, gateway, operations), c.enableCSRF, !c.disableHeaderCheck)
+	mux.Handle("/api/"+apiVersion2+"/", gziphandler.GzipHandler(withErrorTypes(handlerV2)))
+
 	return mux
 }
@@ -17,6 +17,10 @@ func defaultMuxConfig() muxConfig {
 		host:       configuredHost,
 		enableCSRF: false,
 		mode:       skyWallet.DeviceTypeUSB,
+
+		maxAddressIndex:    DefaultMaxAddressIndex,
+		addressLookupLimit: DefaultAddressLookupLimit,
+		maxEntropyBytes:    DefaultMaxEntropyBytes,
 	}
 }
 
@@ -30,12 +34,26 @@ var endpointsMethods = map[string][]string{
 	"/api/v1/backup": []string{
 		http.MethodPost,
 	},
+	"/api/v1/backup/verify": []string{
+		http.MethodGet,
+		http.MethodPost,
+	},
+	"/api/v1/provision": []string{
+		http.MethodGet,
+		http.MethodPost,
+	},
+	"/api/v1/provision/resume": []string{
+		http.MethodPost,
+	},
 	"/api/v1/cancel": []string{
 		http.MethodPut,
 	},
 	"/api/v1/check_message_signature": []string{
 		http.MethodPost,
 	},
+	"/api/v1/check_message_signature/armored": []string{
+		http.MethodPost,
+	},
 	"/api/v1/features": []string{
 		http.MethodGet,
 	},
@@ -48,12 +66,21 @@ var endpointsMethods = map[string][]string{
 	"/api/v1/set_mnemonic": []string{
 		http.MethodPost,
 	},
+	"/api/v1/mnemonic/validate": []string{
+		http.MethodPost,
+	},
+	"/api/v1/mnemonic/words": []string{
+		http.MethodGet,
+	},
 	"/api/v1/configure_pin_code": []string{
 		http.MethodPost,
 	},
 	"/api/v1/sign_message": []string{
 		http.MethodPost,
 	},
+	"/api/v1/sign_messages": []string{
+		http.MethodPost,
+	},
 	"/api/v1/transaction_sign": []string{
 		http.MethodPost,
 	},
@@ -66,6 +93,39 @@ var endpointsMethods = map[string][]string{
 	"/api/v1/version": []string{
 		http.MethodGet,
 	},
+	"/api/v1/openapi.json": []string{
+		http.MethodGet,
+	},
+	"/api/v1/rpc": []string{
+		http.MethodPost,
+	},
+	"/api/v1/audit": []string{
+		http.MethodGet,
+	},
+	"/api/v1/addresses": []string{
+		http.MethodGet,
+	},
+	"/api/v1/addresses/0/qr": []string{
+		http.MethodGet,
+	},
+	"/api/v1/account_discovery": []string{
+		http.MethodPost,
+	},
+	"/api/v1/verify_address": []string{
+		http.MethodPost,
+	},
+	"/api/v1/address_index": []string{
+		http.MethodPost,
+	},
+	"/api/v1/entropy/raw": []string{
+		http.MethodGet,
+	},
+	"/api/v1/entropy/mixed": []string{
+		http.MethodGet,
+	},
+	"/api/v1/entropy/report": []string{
+		http.MethodGet,
+	},
 }
 
 func allEndpoints() []string {
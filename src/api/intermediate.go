@@ -12,7 +12,7 @@ type PinMatrixRequest struct {
 	Pin string `json:"pin"`
 }
 
-func pinMatrixRequestHandler(gateway Gatewayer) http.HandlerFunc {
+func pinMatrixRequestHandler(gateway Gatewayer, flows *deviceFlows) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
@@ -46,8 +46,9 @@ func pinMatrixRequestHandler(gateway Gatewayer) http.HandlerFunc {
 
 		select {
 		case <-retCH:
-			HandleFirmwareResponseMessages(w, msg)
+			flows.resume(w, r, gateway, msg)
 		case <-errCH:
+			flows.fail(err)
 			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
 			writeHTTPResponse(w, resp)
 		case <-ctx.Done():
@@ -68,7 +69,7 @@ type PassPhraseRequest struct {
 	Passphrase string `json:"passphrase"`
 }
 
-func passphraseRequestHandler(gateway Gatewayer) http.HandlerFunc {
+func passphraseRequestHandler(gateway Gatewayer, addressBook *AddressBook, flows *deviceFlows) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
@@ -90,6 +91,9 @@ func passphraseRequestHandler(gateway Gatewayer) http.HandlerFunc {
 		errCH := make(chan int)
 		ctx := r.Context()
 
+		// a different passphrase derives different addresses
+		addressBook.forgetSessions()
+
 		go func() {
 			msg, err = gateway.PassphraseAck(req.Passphrase)
 			if err != nil {
@@ -102,8 +106,9 @@ func passphraseRequestHandler(gateway Gatewayer) http.HandlerFunc {
 
 		select {
 		case <-retCH:
-			HandleFirmwareResponseMessages(w, msg)
+			flows.resume(w, r, gateway, msg)
 		case <-errCH:
+			flows.fail(err)
 			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
 			writeHTTPResponse(w, resp)
 		case <-ctx.Done():
@@ -124,7 +129,7 @@ type WordRequest struct {
 	Word string `json:"word"`
 }
 
-func wordRequestHandler(gateway Gatewayer) http.HandlerFunc {
+func wordRequestHandler(gateway Gatewayer, backups *backupVerification, flows *deviceFlows) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
@@ -140,6 +145,17 @@ func wordRequestHandler(gateway Gatewayer) http.HandlerFunc {
 		}
 		defer r.Body.Close()
 
+		// a word which is not in the wordlist would fail the recovery on the device
+		if _, ok := mnemonicWords[req.Word]; !ok {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, "word is not in the bip39 english wordlist")
+			resp.Data = InvalidMnemonicWord{
+				Word:        req.Word,
+				Suggestions: suggestMnemonicWords(req.Word),
+			}
+			writeHTTPResponse(w, resp)
+			return
+		}
+
 		var msg wire.Message
 		var err error
 		retCH := make(chan int)
@@ -153,13 +169,16 @@ func wordRequestHandler(gateway Gatewayer) http.HandlerFunc {
 				return
 			}
 
+			backups.wordEntered()
+
 			retCH <- 1
 		}()
 
 		select {
 		case <-retCH:
-			HandleFirmwareResponseMessages(w, msg)
+			flows.resume(w, r, gateway, msg)
 		case <-errCH:
+			flows.fail(err)
 			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
 			writeHTTPResponse(w, resp)
 		case <-ctx.Done():
@@ -175,7 +194,7 @@ func wordRequestHandler(gateway Gatewayer) http.HandlerFunc {
 	}
 }
 
-func buttonRequestHandler(gateway Gatewayer) http.HandlerFunc {
+func buttonRequestHandler(gateway Gatewayer, flows *deviceFlows) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
@@ -200,8 +219,9 @@ func buttonRequestHandler(gateway Gatewayer) http.HandlerFunc {
 
 		select {
 		case <-retCH:
-			HandleFirmwareResponseMessages(w, msg)
+			flows.resume(w, r, gateway, msg)
 		case <-errCH:
+			flows.fail(err)
 			logger.Errorf("button ack failed: %s", err.Error())
 			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
 			writeHTTPResponse(w, resp)
@@ -217,3 +237,50 @@ func buttonRequestHandler(gateway Gatewayer) http.HandlerFunc {
 		}
 	}
 }
+
+// passphraseStateRequestHandler acknowledges the passphrase state the device sends after a passphrase is entered
+// URI: /api/v1/intermediate/passphrase_state
+// Method: POST
+func passphraseStateRequestHandler(gateway Gatewayer, flows *deviceFlows) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		var msg wire.Message
+		var err error
+		retCH := make(chan int, 1)
+		errCH := make(chan int, 1)
+		ctx := r.Context()
+
+		go func() {
+			msg, err = gateway.PassphraseStateAck()
+			if err != nil {
+				errCH <- 1
+				return
+			}
+			retCH <- 1
+		}()
+
+		select {
+		case <-retCH:
+			flows.resume(w, r, gateway, msg)
+		case <-errCH:
+			flows.fail(err)
+			logger.Errorf("passphrase state ack failed: %s", err.Error())
+			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+			writeHTTPResponse(w, resp)
+		case <-ctx.Done():
+			disConnErr := gateway.Disconnect()
+			if disConnErr != nil {
+				resp := NewHTTPErrorResponse(http.StatusInternalServerError, disConnErr.Error())
+				writeHTTPResponse(w, resp)
+			} else {
+				resp := NewHTTPErrorResponse(499, "Client Closed Request")
+				writeHTTPResponse(w, resp)
+			}
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	"github.com/gogo/protobuf/proto"
+
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+)
+
+// Kinds of intermediate response, each one is answered through /api/v1/intermediate/<kind>
+const (
+	IntermediateKindButton          = "button"
+	IntermediateKindPinMatrix       = "pin_matrix"
+	IntermediateKindPassphrase      = "passphrase"
+	IntermediateKindWord            = "word"
+	IntermediateKindPassphraseState = "passphrase_state"
+)
+
+// IntermediateResponse describes the user input the device is waiting for.
+// It is returned along with the message type array in the data of an intermediate response.
+type IntermediateResponse struct {
+	Kind string `json:"kind"`
+	// Operation is the endpoint, relative to /api/v1, of the flow the device is running
+	Operation string `json:"operation,omitempty"`
+	// ButtonRequestType is what the user confirms on the device, for a button request
+	ButtonRequestType string `json:"button_request_type,omitempty"`
+	// PinMatrixRequestType is the pin code the user enters, for a pin matrix request
+	PinMatrixRequestType string `json:"pin_matrix_request_type,omitempty"`
+}
+
+// buttonRequestTypes maps the ButtonRequestType of a firmware ButtonRequest to its name
+var buttonRequestTypes = map[messages.ButtonRequestType]string{
+	messages.ButtonRequestType_ButtonRequest_Other:             "other",
+	messages.ButtonRequestType_ButtonRequest_FeeOverThreshold:  "fee_over_threshold",
+	messages.ButtonRequestType_ButtonRequest_ConfirmOutput:     "confirm_output",
+	messages.ButtonRequestType_ButtonRequest_ResetDevice:       "reset_device",
+	messages.ButtonRequestType_ButtonRequest_ConfirmWord:       "confirm_word",
+	messages.ButtonRequestType_ButtonRequest_WipeDevice:        "wipe_device",
+	messages.ButtonRequestType_ButtonRequest_ProtectCall:       "protect_call",
+	messages.ButtonRequestType_ButtonRequest_SignTx:            "sign_tx",
+	messages.ButtonRequestType_ButtonRequest_FirmwareCheck:     "firmware_check",
+	messages.ButtonRequestType_ButtonRequest_Address:           "address",
+	messages.ButtonRequestType_ButtonRequest_PublicKey:         "public_key",
+	messages.ButtonRequestType_ButtonRequest_MnemonicWordCount: "mnemonic_word_count",
+	messages.ButtonRequestType_ButtonRequest_MnemonicInput:     "mnemonic_input",
+	messages.ButtonRequestType_ButtonRequest_PassphraseType:    "passphrase_type",
+}
+
+// pinMatrixRequestTypes maps the PinMatrixRequestType of a firmware PinMatrixRequest to its name
+var pinMatrixRequestTypes = map[messages.PinMatrixRequestType]string{
+	messages.PinMatrixRequestType_PinMatrixRequestType_Current:   "current",
+	messages.PinMatrixRequestType_PinMatrixRequestType_NewFirst:  "new",
+	messages.PinMatrixRequestType_PinMatrixRequestType_NewSecond: "confirm",
+}
+
+// newIntermediateResponse describes an intermediate firmware message
+func newIntermediateResponse(msg wire.Message, operation string) (*IntermediateResponse, error) {
+	rsp := &IntermediateResponse{
+		Operation: operation,
+	}
+
+	switch msg.Kind {
+	case uint16(messages.MessageType_MessageType_ButtonRequest):
+		rsp.Kind = IntermediateKindButton
+
+		buttonRequest := &messages.ButtonRequest{}
+		if err := proto.Unmarshal(msg.Data, buttonRequest); err != nil {
+			return nil, err
+		}
+		if buttonRequest.Code != nil {
+			rsp.ButtonRequestType = buttonRequestTypes[buttonRequest.GetCode()]
+		}
+	case uint16(messages.MessageType_MessageType_PinMatrixRequest):
+		rsp.Kind = IntermediateKindPinMatrix
+
+		pinMatrixRequest := &messages.PinMatrixRequest{}
+		if err := proto.Unmarshal(msg.Data, pinMatrixRequest); err != nil {
+			return nil, err
+		}
+		if pinMatrixRequest.Type != nil {
+			rsp.PinMatrixRequestType = pinMatrixRequestTypes[pinMatrixRequest.GetType()]
+		}
+	case uint16(messages.MessageType_MessageType_PassphraseRequest):
+		rsp.Kind = IntermediateKindPassphrase
+	case uint16(messages.MessageType_MessageType_WordRequest):
+		rsp.Kind = IntermediateKindWord
+	case uint16(messages.MessageType_MessageType_PassphraseStateRequest):
+		rsp.Kind = IntermediateKindPassphraseState
+	}
+
+	return rsp, nil
+}
+
+// writeIntermediateResponse writes an intermediate firmware message. The message type is kept
+// in the data for the clients which only read it.
+func writeIntermediateResponse(w http.ResponseWriter, msg wire.Message, messageType string) {
+	intermediate, err := newIntermediateResponse(msg, promptOperation(w))
+	if err != nil {
+		resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+		writeHTTPResponse(w, resp)
+		return
+	}
+
+	writeHTTPResponse(w, HTTPResponse{
+		Data:         []string{messageType},
+		Intermediate: intermediate,
+	})
+}
+
+// operationTracker remembers the operation of the last flow which asked for user input,
+// the intermediate requests which answer it continue that operation
+type operationTracker struct {
+	lock      sync.Mutex
+	operation string
+}
+
+func (o *operationTracker) get() string {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	return o.operation
+}
+
+func (o *operationTracker) set(operation string) {
+	o.lock.Lock()
+	o.operation = operation
+	o.lock.Unlock()
+}
+
+// operationResponseWriter is the response writer of a request which may return an intermediate response
+type operationResponseWriter struct {
+	http.ResponseWriter
+	operations *operationTracker
+	// operation is empty for an intermediate request
+	operation string
+}
+
+// promptOperation returns the operation of an intermediate response written to w.
+// The operation of a flow is tracked once it asks for user input.
+func promptOperation(w http.ResponseWriter) string {
+	ow, ok := w.(*operationResponseWriter)
+	if !ok {
+		return ""
+	}
+
+	if ow.operation == "" {
+		return ow.operations.get()
+	}
+
+	ow.operations.set(ow.operation)
+	return ow.operation
+}
+
+// trackOperation wraps the handler of an endpoint, its flows are tracked as the endpoint operation
+func trackOperation(endpoint string, operations *operationTracker, handler http.Handler) http.Handler {
+	operation := strings.Trim(endpoint, "/")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(&operationResponseWriter{
+			ResponseWriter: w,
+			operations:     operations,
+			operation:      operation,
+		}, r)
+	})
+}
+
+// continueOperation wraps the handler of an intermediate endpoint, which continues the tracked operation
+func continueOperation(operations *operationTracker, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(&operationResponseWriter{
+			ResponseWriter: w,
+			operations:     operations,
+		}, r)
+	})
+}
@@ -0,0 +1,161 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+	"github.com/stretchr/testify/require"
+)
+
+func testButtonRequestMessage(t *testing.T, code messages.ButtonRequestType) wire.Message {
+	buttonRequest := messages.ButtonRequest{
+		Code: code.Enum(),
+	}
+
+	data, err := buttonRequest.Marshal()
+	require.NoError(t, err)
+
+	return wire.Message{
+		Kind: uint16(messages.MessageType_MessageType_ButtonRequest),
+		Data: data,
+	}
+}
+
+func testPinMatrixRequestMessage(t *testing.T, pinType messages.PinMatrixRequestType) wire.Message {
+	pinMatrixRequest := messages.PinMatrixRequest{
+		Type: pinType.Enum(),
+	}
+
+	data, err := pinMatrixRequest.Marshal()
+	require.NoError(t, err)
+
+	return wire.Message{
+		Kind: uint16(messages.MessageType_MessageType_PinMatrixRequest),
+		Data: data,
+	}
+}
+
+func TestNewIntermediateResponse(t *testing.T) {
+	cases := []struct {
+		name         string
+		msg          wire.Message
+		intermediate IntermediateResponse
+	}{
+		{
+			name: "button request",
+			msg:  testButtonRequestMessage(t, messages.ButtonRequestType_ButtonRequest_WipeDevice),
+			intermediate: IntermediateResponse{
+				Kind:              IntermediateKindButton,
+				Operation:         "wipe",
+				ButtonRequestType: "wipe_device",
+			},
+		},
+
+		{
+			name: "button request without code",
+			msg:  buttonRequestMessage,
+			intermediate: IntermediateResponse{
+				Kind:      IntermediateKindButton,
+				Operation: "wipe",
+			},
+		},
+
+		{
+			name: "current pin",
+			msg:  testPinMatrixRequestMessage(t, messages.PinMatrixRequestType_PinMatrixRequestType_Current),
+			intermediate: IntermediateResponse{
+				Kind:                 IntermediateKindPinMatrix,
+				Operation:            "wipe",
+				PinMatrixRequestType: "current",
+			},
+		},
+
+		{
+			name: "new pin",
+			msg:  testPinMatrixRequestMessage(t, messages.PinMatrixRequestType_PinMatrixRequestType_NewFirst),
+			intermediate: IntermediateResponse{
+				Kind:                 IntermediateKindPinMatrix,
+				Operation:            "wipe",
+				PinMatrixRequestType: "new",
+			},
+		},
+
+		{
+			name: "confirm pin",
+			msg:  testPinMatrixRequestMessage(t, messages.PinMatrixRequestType_PinMatrixRequestType_NewSecond),
+			intermediate: IntermediateResponse{
+				Kind:                 IntermediateKindPinMatrix,
+				Operation:            "wipe",
+				PinMatrixRequestType: "confirm",
+			},
+		},
+
+		{
+			name: "word request",
+			msg:  wordRequestMessage,
+			intermediate: IntermediateResponse{
+				Kind:      IntermediateKindWord,
+				Operation: "wipe",
+			},
+		},
+
+		{
+			name: "passphrase state request",
+			msg:  passphraseStateRequestMessage,
+			intermediate: IntermediateResponse{
+				Kind:      IntermediateKindPassphraseState,
+				Operation: "wipe",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			intermediate, err := newIntermediateResponse(tc.msg, "wipe")
+			require.NoError(t, err)
+			require.Equal(t, tc.intermediate, *intermediate)
+		})
+	}
+}
+
+func TestIntermediateResponseOperation(t *testing.T) {
+	gateway := &MockGatewayer{}
+	gateway.On("ChangePin", newBoolPtr(false)).Return(testPinMatrixRequestMessage(t, messages.PinMatrixRequestType_PinMatrixRequestType_Current), nil)
+	gateway.On("PinMatrixAck", "1234").Return(testPinMatrixRequestMessage(t, messages.PinMatrixRequestType_PinMatrixRequestType_NewFirst), nil)
+	gateway.On("PinMatrixAck", "5678").Return(testPinMatrixRequestMessage(t, messages.PinMatrixRequestType_PinMatrixRequestType_NewSecond), nil)
+	gateway.On("GetFeatures").Return(testFeaturesMessage(t, "device-id", false), nil)
+
+	handler := newServerMux(defaultMuxConfig(), gateway)
+
+	rr := serveTestRequest(t, handler, http.MethodPost, "/configure_pin_code", toJSON(t, ConfigurePinCodeRequest{}), map[string]string{
+		"Content-Type": ContentTypeJSON,
+	})
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	var rsp ReceivedHTTPResponse
+	err := json.NewDecoder(rr.Body).Decode(&rsp)
+	require.NoError(t, err)
+	require.JSONEq(t, `["PinMatrixRequest"]`, string(rsp.Data))
+	require.Equal(t, &IntermediateResponse{
+		Kind:                 IntermediateKindPinMatrix,
+		Operation:            "configure_pin_code",
+		PinMatrixRequestType: "current",
+	}, rsp.Intermediate)
+
+	rr = serveTestRequest(t, handler, http.MethodPost, "/intermediate/pin_matrix", toJSON(t, PinMatrixRequest{Pin: "1234"}), nil)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	require.JSONEq(t, `{"data":["PinMatrixRequest"],"intermediate":{"kind":"pin_matrix","operation":"configure_pin_code","pin_matrix_request_type":"new"}}`, rr.Body.String())
+
+	// a request which does not ask for user input does not change the operation
+	rr = serveTestRequest(t, handler, http.MethodGet, "/features", "", nil)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	rr = serveTestRequest(t, handler, http.MethodPost, "/intermediate/pin_matrix", toJSON(t, PinMatrixRequest{Pin: "5678"}), nil)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	require.JSONEq(t, `{"data":["PinMatrixRequest"],"intermediate":{"kind":"pin_matrix","operation":"configure_pin_code","pin_matrix_request_type":"confirm"}}`, rr.Body.String())
+
+	gateway.AssertExpectations(t)
+}
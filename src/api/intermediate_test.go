@@ -0,0 +1,152 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+	"github.com/stretchr/testify/require"
+)
+
+var passphraseStateRequestMessage = wire.Message{
+	Kind: uint16(messages.MessageType_MessageType_PassphraseStateRequest),
+}
+
+func TestPassphraseStateRequest(t *testing.T) {
+	cases := []struct {
+		name         string
+		method       string
+		status       int
+		result       wire.Message
+		err          error
+		httpResponse HTTPResponse
+	}{
+		{
+			name:         "405",
+			method:       http.MethodGet,
+			status:       http.StatusMethodNotAllowed,
+			httpResponse: NewHTTPErrorResponse(http.StatusMethodNotAllowed, ""),
+		},
+
+		{
+			name:         "500 - gateway error",
+			method:       http.MethodPost,
+			status:       http.StatusInternalServerError,
+			err:          errors.New("no device connected"),
+			httpResponse: NewHTTPErrorResponse(http.StatusInternalServerError, "no device connected"),
+		},
+
+		{
+			name:   "200 - ButtonRequest",
+			method: http.MethodPost,
+			status: http.StatusOK,
+			result: buttonRequestMessage,
+			httpResponse: HTTPResponse{
+				Data: []string{"ButtonRequest"},
+			},
+		},
+
+		{
+			name:   "200 - OK",
+			method: http.MethodPost,
+			status: http.StatusOK,
+			result: testSuccessMessage(t, "passphrase state saved"),
+			httpResponse: HTTPResponse{
+				Data: []string{"passphrase state saved"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+			gateway.On("PassphraseStateAck").Return(tc.result, tc.err)
+
+			handler := newServerMux(defaultMuxConfig(), gateway)
+
+			rr := serveTestRequest(t, handler, tc.method, "/intermediate/passphrase_state", "", nil)
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+
+			var rsp ReceivedHTTPResponse
+			err := json.NewDecoder(rr.Body).Decode(&rsp)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.httpResponse.Error, rsp.Error)
+
+			if rsp.Data == nil {
+				require.Nil(t, tc.httpResponse.Data)
+			} else {
+				require.NotNil(t, tc.httpResponse.Data)
+				require.JSONEq(t, toJSON(t, tc.httpResponse.Data), string(rsp.Data))
+			}
+		})
+	}
+}
+
+func TestPassphraseStateRequestFlow(t *testing.T) {
+	auditLog, cleanup := newTestAuditLog(t)
+	defer cleanup()
+
+	gateway := &MockGatewayer{}
+	gateway.On("GetFeatures").Return(testFeaturesMessage(t, "device-1", true), nil)
+	gateway.On("SignMessage", 0, "hello").Return(wire.Message{
+		Kind: uint16(messages.MessageType_MessageType_PassphraseRequest),
+	}, nil)
+	gateway.On("PassphraseAck", "secret").Return(passphraseStateRequestMessage, nil)
+	gateway.On("PassphraseStateAck").Return(testFailureMessage(t, "Action cancelled by user"), nil)
+
+	config := defaultMuxConfig()
+	config.auditLog = auditLog
+	handler := newServerMux(config, gateway)
+
+	rr := serveTestRequest(t, handler, http.MethodPost, "/sign_message", toJSON(t, SignMessageRequest{
+		AddressN: 0,
+		Message:  "hello",
+	}), map[string]string{
+		"Content-Type": ContentTypeJSON,
+	})
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	require.JSONEq(t, `{"data":["PassPhraseRequest"],"intermediate":{"kind":"passphrase","operation":"sign_message"}}`, rr.Body.String())
+
+	// the passphrase state request is an intermediate request, the operation is still pending
+	rr = serveTestRequest(t, handler, http.MethodPost, "/intermediate/passphrase", toJSON(t, PassPhraseRequest{Passphrase: "secret"}), nil)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	require.JSONEq(t, `{"data":["PassphraseStateRequest"],"intermediate":{"kind":"passphrase_state","operation":"sign_message"}}`, rr.Body.String())
+
+	entries, err := auditLog.Entries(AuditFilter{})
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	rr = serveTestRequest(t, handler, http.MethodPost, "/intermediate/passphrase_state", "", nil)
+	require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+
+	entries, err = auditLog.Entries(AuditFilter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "sign_message", entries[0].Operation)
+	require.Equal(t, "Failure", entries[0].Result)
+	require.Equal(t, "Action cancelled by user", entries[0].Message)
+
+	gateway.AssertExpectations(t)
+}
+
+func TestHandleFirmwareResponseMessagesUnsupported(t *testing.T) {
+	rr := httptest.NewRecorder()
+	HandleFirmwareResponseMessages(rr, wire.Message{
+		Kind: uint16(messages.MessageType_MessageType_TxRequest),
+	})
+	require.Equal(t, http.StatusNotImplemented, rr.Code)
+
+	var rsp ReceivedHTTPResponse
+	err := json.NewDecoder(rr.Body).Decode(&rsp)
+	require.NoError(t, err)
+	require.Equal(t, &HTTPError{
+		Code:    http.StatusNotImplemented,
+		Message: "unsupported firmware request: MessageType_TxRequest",
+		Type:    ErrorTypeUnsupportedFirmwareRequest,
+	}, rsp.Error)
+}
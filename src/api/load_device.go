@@ -0,0 +1,106 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+)
+
+// LoadDeviceRequest is request data for /api/v1/emulator/load_device
+type LoadDeviceRequest struct {
+	Mnemonic             string `json:"mnemonic"`
+	Pin                  string `json:"pin"`
+	PassphraseProtection bool   `json:"passphrase_protection"`
+	Label                string `json:"label"`
+}
+
+// loadDevice configures the emulator with a mnemonic, pin code, label and passphrase protection
+// in one request, to set up integration test fixtures. It is only registered for the emulator.
+// URI: /api/v1/emulator/load_device
+// Method: POST
+// Args: JSON Body
+func loadDevice(gateway Gatewayer, auditLog *AuditLog, addressBook *AddressBook) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if r.Header.Get("Content-Type") != ContentTypeJSON {
+			resp := NewHTTPErrorResponse(http.StatusUnsupportedMediaType, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		var req LoadDeviceRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			resp := NewHTTPErrorResponse(http.StatusBadRequest, err.Error())
+			writeHTTPResponse(w, resp)
+			return
+		}
+		defer r.Body.Close()
+
+		if v := validateMnemonic(req.Mnemonic); !v.Valid {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, "seed is not a valid bip39 seed: "+v.Error)
+			resp.Data = v
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		// the pin code is entered with the digits of the pin matrix
+		if strings.Trim(req.Pin, "123456789") != "" {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, "pin can only contain digits from 1 to 9")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		auditEntry := auditLog.begin(r, gateway, "load_device", auditLoadDevice{
+			WordCount:            len(strings.Fields(req.Mnemonic)),
+			PinProtection:        req.Pin != "",
+			PassphraseProtection: req.PassphraseProtection,
+			Label:                req.Label,
+		})
+
+		addressBookDone := addressBook.reset(gateway)
+
+		var msg wire.Message
+		var err error
+		retCH := make(chan int)
+		errCH := make(chan int)
+		ctx := r.Context()
+
+		go func() {
+			msg, err = gateway.LoadDevice(req.Mnemonic, req.Pin, req.PassphraseProtection, req.Label)
+			if err != nil {
+				errCH <- 1
+				return
+			}
+			retCH <- 1
+		}()
+
+		select {
+		case <-retCH:
+			addressBook.finish(gateway, addressBookDone, msg)
+			auditLog.finish(auditEntry, msg)
+			HandleFirmwareResponseMessages(w, msg)
+		case <-errCH:
+			logger.Errorf("loadDevice failed: %s", err.Error())
+			auditLog.finishWithResult(auditEntry, auditResultError, err.Error())
+			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+			writeHTTPResponse(w, resp)
+		case <-ctx.Done():
+			auditLog.finishWithResult(auditEntry, auditResultClosed, "")
+			disConnErr := gateway.Disconnect()
+			if disConnErr != nil {
+				resp := NewHTTPErrorResponse(http.StatusInternalServerError, disConnErr.Error())
+				writeHTTPResponse(w, resp)
+			} else {
+				resp := NewHTTPErrorResponse(499, "Client Closed Request")
+				writeHTTPResponse(w, resp)
+			}
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	"github.com/stretchr/testify/require"
+
+	skyWallet "github.com/SkycoinProject/hardware-wallet-go/src/skywallet"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+)
+
+func TestLoadDevice(t *testing.T) {
+	mnemonic := "cloud flower upset remain green metal below cup stem infant art thank"
+
+	cases := []struct {
+		name         string
+		method       string
+		status       int
+		contentType  string
+		httpBody     string
+		result       wire.Message
+		err          error
+		httpResponse HTTPResponse
+	}{
+		{
+			name:         "405",
+			method:       http.MethodGet,
+			status:       http.StatusMethodNotAllowed,
+			httpResponse: NewHTTPErrorResponse(http.StatusMethodNotAllowed, ""),
+		},
+
+		{
+			name:         "415 - Unsupported Media Type",
+			method:       http.MethodPost,
+			contentType:  ContentTypeForm,
+			status:       http.StatusUnsupportedMediaType,
+			httpResponse: NewHTTPErrorResponse(http.StatusUnsupportedMediaType, ""),
+		},
+
+		{
+			name:   "422 - checksum incorrect",
+			method: http.MethodPost,
+			status: http.StatusUnprocessableEntity,
+			httpBody: toJSON(t, &LoadDeviceRequest{
+				Mnemonic: "cloud flower upset remain green metal below cup stem infant art art",
+			}),
+			httpResponse: HTTPResponse{
+				Error: &HTTPError{
+					Code:    http.StatusUnprocessableEntity,
+					Message: "seed is not a valid bip39 seed: mnemonic checksum is incorrect",
+				},
+				Data: MnemonicValidation{
+					WordCount: 12,
+					Error:     "mnemonic checksum is incorrect",
+				},
+			},
+		},
+
+		{
+			name:   "422 - invalid pin",
+			method: http.MethodPost,
+			status: http.StatusUnprocessableEntity,
+			httpBody: toJSON(t, &LoadDeviceRequest{
+				Mnemonic: mnemonic,
+				Pin:      "1230",
+			}),
+			httpResponse: NewHTTPErrorResponse(http.StatusUnprocessableEntity, "pin can only contain digits from 1 to 9"),
+		},
+
+		{
+			name:   "409 - Failure msg",
+			method: http.MethodPost,
+			status: http.StatusConflict,
+			result: testFailureCodeMessage(t, messages.FailureType_Failure_UnexpectedMessage, "Device is already initialized. Use Wipe first."),
+			httpBody: toJSON(t, &LoadDeviceRequest{
+				Mnemonic: mnemonic,
+			}),
+			httpResponse: newTypedHTTPErrorResponse(http.StatusConflict, ErrorTypeUnexpectedMessage, "Device is already initialized. Use Wipe first."),
+		},
+
+		{
+			name:   "500 - gateway error",
+			method: http.MethodPost,
+			status: http.StatusInternalServerError,
+			err:    errors.New("no device connected"),
+			httpBody: toJSON(t, &LoadDeviceRequest{
+				Mnemonic: mnemonic,
+			}),
+			httpResponse: NewHTTPErrorResponse(http.StatusInternalServerError, "no device connected"),
+		},
+
+		{
+			name:   "200 - OK",
+			method: http.MethodPost,
+			status: http.StatusOK,
+			result: testSuccessMessage(t, "Device loaded"),
+			httpBody: toJSON(t, &LoadDeviceRequest{
+				Mnemonic:             mnemonic,
+				Pin:                  "1234",
+				PassphraseProtection: true,
+				Label:                "fixture",
+			}),
+			httpResponse: HTTPResponse{
+				Data: []string{"Device loaded"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+
+			var body LoadDeviceRequest
+			if err := json.Unmarshal([]byte(tc.httpBody), &body); err == nil {
+				gateway.On("LoadDevice", body.Mnemonic, body.Pin, body.PassphraseProtection, body.Label).Return(tc.result, tc.err)
+			}
+
+			contentType := tc.contentType
+			if contentType == "" {
+				contentType = ContentTypeJSON
+			}
+
+			config := defaultMuxConfig()
+			config.mode = skyWallet.DeviceTypeEmulator
+			handler := newServerMux(config, gateway)
+
+			rr := serveTestRequest(t, handler, tc.method, "/emulator/load_device", tc.httpBody, map[string]string{
+				"Content-Type": contentType,
+			})
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+
+			var rsp ReceivedHTTPResponse
+			err := json.NewDecoder(rr.Body).Decode(&rsp)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.httpResponse.Error, rsp.Error)
+
+			if rsp.Data == nil {
+				require.Nil(t, tc.httpResponse.Data)
+			} else {
+				require.NotNil(t, tc.httpResponse.Data)
+				require.JSONEq(t, toJSON(t, tc.httpResponse.Data), string(rsp.Data))
+			}
+		})
+	}
+}
+
+func TestLoadDeviceNotEmulator(t *testing.T) {
+	gateway := &MockGatewayer{}
+	handler := newServerMux(defaultMuxConfig(), gateway)
+
+	rr := serveTestRequest(t, handler, http.MethodPost, "/emulator/load_device", toJSON(t, &LoadDeviceRequest{
+		Mnemonic: "cloud flower upset remain green metal below cup stem infant art thank",
+	}), map[string]string{
+		"Content-Type": ContentTypeJSON,
+	})
+	require.Equal(t, http.StatusNotFound, rr.Code)
+
+	gateway.AssertNotCalled(t, "LoadDevice")
+}
+
+func TestMessageChunks(t *testing.T) {
+	chunks := messageChunks(messages.MessageType_MessageType_LoadDevice, []byte{1, 2, 3})
+	require.Len(t, chunks, 1)
+	require.Equal(t, []byte{'?', '#', '#', 0, 13, 0, 0, 0, 3, 1, 2, 3, 0}, chunks[0][:13])
+
+	// the header and 110 bytes of data fill two reports of 63 bytes
+	data := make([]byte, 110)
+	for i := range data {
+		data[i] = byte(i + 1)
+	}
+	chunks = messageChunks(messages.MessageType_MessageType_LoadDevice, data)
+	require.Len(t, chunks, 2)
+	require.Equal(t, byte('?'), chunks[1][0])
+	require.Equal(t, data[55:], chunks[1][1:56])
+	require.Equal(t, make([]byte, 8), chunks[1][56:])
+}
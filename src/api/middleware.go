@@ -1,11 +1,13 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
 
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
 	"github.com/SkycoinProject/skycoin/src/util/iputil"
 )
 
@@ -138,3 +140,112 @@ func originRefererCheck(host string, hostWhitelist []string, handler http.Handle
 		handler.ServeHTTP(w, r)
 	})
 }
+
+// deviceFlow tracks a device flow which waits for user input between requests
+type deviceFlow interface {
+	// interrupt forgets the flow, a new device flow replaced it on the device
+	interrupt()
+	// resumeFlow handles the firmware response of an intermediate request.
+	// It returns true if it wrote the response.
+	resumeFlow(w http.ResponseWriter, r *http.Request, gateway Gatewayer, msg wire.Message) bool
+	// failFlow records a daemon side error of an intermediate request
+	failFlow(err error)
+}
+
+// flowCanceller is a deviceFlow which records the answer of the device to a cancel request
+type flowCanceller interface {
+	cancelFlow(gateway Gatewayer, msg wire.Message)
+}
+
+// deviceFlows is the registry of the tracked device flows. The handlers get the registry
+// instead of the flows, so a new flow is only registered where the server mux is created.
+type deviceFlows struct {
+	flows []deviceFlow
+}
+
+// newDeviceFlows creates a registry of flows, they are resumed in order
+func newDeviceFlows(flows ...deviceFlow) *deviceFlows {
+	return &deviceFlows{
+		flows: flows,
+	}
+}
+
+// interrupt interrupts every tracked flow still waiting for user input, the device flow which is starting
+// replaces it on the device. The flows in keep are not interrupted, they are the one which is starting.
+func (f *deviceFlows) interrupt(keep ...deviceFlow) {
+	for _, flow := range f.flows {
+		if !containsFlow(keep, flow) {
+			flow.interrupt()
+		}
+	}
+}
+
+func containsFlow(flows []deviceFlow, flow deviceFlow) bool {
+	for _, f := range flows {
+		if f == flow {
+			return true
+		}
+	}
+	return false
+}
+
+// interrupting wraps a handler for an endpoint which starts a new device flow
+func (f *deviceFlows) interrupting(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.interrupt()
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// resume hands the firmware response of an intermediate request to the tracked flows, in order,
+// until one of them writes the response. If none does, the response is written as is.
+func (f *deviceFlows) resume(w http.ResponseWriter, r *http.Request, gateway Gatewayer, msg wire.Message) {
+	for _, flow := range f.flows {
+		if flow.resumeFlow(w, r, gateway, msg) {
+			return
+		}
+	}
+
+	HandleFirmwareResponseMessages(w, msg)
+}
+
+// fail records a daemon side error of an intermediate request in the tracked flows
+func (f *deviceFlows) fail(err error) {
+	for _, flow := range f.flows {
+		flow.failFlow(err)
+	}
+}
+
+// cancel records the answer of the device to a cancel request in the tracked flows
+func (f *deviceFlows) cancel(gateway Gatewayer, msg wire.Message) {
+	for _, flow := range f.flows {
+		if c, ok := flow.(flowCanceller); ok {
+			c.cancelFlow(gateway, msg)
+		}
+	}
+}
+
+type flusherKey struct{}
+
+// withFlusher keeps the http.Flusher of the response available to the handler,
+// for response writer wrappers which do not implement it
+func withFlusher(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if f, ok := w.(http.Flusher); ok {
+			r = r.WithContext(context.WithValue(r.Context(), flusherKey{}, f))
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// flush sends buffered response data to the client
+func flush(w http.ResponseWriter, r *http.Request) {
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+		return
+	}
+
+	if f, ok := r.Context().Value(flusherKey{}).(http.Flusher); ok {
+		f.Flush()
+	}
+}
@@ -0,0 +1,253 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/SkycoinProject/skycoin/src/cipher/bip39"
+	"github.com/SkycoinProject/skycoin/src/cipher/bip39/wordlists"
+)
+
+const (
+	// maxMnemonicSuggestions is the maximum number of suggestions for an invalid mnemonic word
+	maxMnemonicSuggestions = 3
+	// maxSuggestionDistance is the maximum edit distance between an invalid word and a suggestion
+	maxSuggestionDistance = 2
+	// mnemonicPrefixLength is the number of letters which identify a word of the bip39 english wordlist
+	mnemonicPrefixLength = 4
+)
+
+// mnemonicWords is a lookup set of the bip39 english wordlist
+var mnemonicWords = func() map[string]struct{} {
+	words := make(map[string]struct{}, len(wordlists.English))
+	for _, w := range wordlists.English {
+		words[w] = struct{}{}
+	}
+	return words
+}()
+
+// mnemonicWordsWithPrefix returns the words of the bip39 english wordlist which start with prefix
+func mnemonicWordsWithPrefix(prefix string) []string {
+	words := []string{}
+	for _, w := range wordlists.English {
+		if strings.HasPrefix(w, prefix) {
+			words = append(words, w)
+		}
+	}
+	return words
+}
+
+// MnemonicValidateRequest is request data for /api/v1/mnemonic/validate
+type MnemonicValidateRequest struct {
+	Mnemonic string `json:"mnemonic"`
+}
+
+// InvalidMnemonicWord is a word of a mnemonic which is not in the bip39 english wordlist
+type InvalidMnemonicWord struct {
+	// Index is the position of the word in the mnemonic, starting from 0
+	Index       int      `json:"index"`
+	Word        string   `json:"word"`
+	Suggestions []string `json:"suggestions"`
+}
+
+// MnemonicValidation is data returned by POST /api/v1/mnemonic/validate
+type MnemonicValidation struct {
+	Valid     bool `json:"valid"`
+	WordCount int  `json:"word_count"`
+	// Error describes why the mnemonic is invalid
+	Error        string                `json:"error,omitempty"`
+	InvalidWords []InvalidMnemonicWord `json:"invalid_words,omitempty"`
+}
+
+// validateMnemonic checks a mnemonic can be used to seed the device: it has 12 or 24 words
+// of the bip39 english wordlist separated by single spaces, and a valid checksum.
+// Words which are not in the wordlist are reported with close matches.
+func validateMnemonic(mnemonic string) MnemonicValidation {
+	words := strings.Fields(mnemonic)
+	v := MnemonicValidation{
+		WordCount: len(words),
+	}
+
+	for i, w := range words {
+		if _, ok := mnemonicWords[w]; !ok {
+			v.InvalidWords = append(v.InvalidWords, InvalidMnemonicWord{
+				Index:       i,
+				Word:        w,
+				Suggestions: suggestMnemonicWords(w),
+			})
+		}
+	}
+
+	switch {
+	case len(words) != 12 && len(words) != 24:
+		v.Error = "mnemonic must have 12 or 24 words"
+	case len(v.InvalidWords) > 0:
+		v.Error = "mnemonic contains words which are not in the bip39 english wordlist"
+	case strings.Join(words, " ") != mnemonic:
+		v.Error = "mnemonic words must be separated by single spaces, without surrounding whitespace"
+	default:
+		if err := bip39.ValidateMnemonic(mnemonic); err != nil {
+			v.Error = "mnemonic checksum is incorrect"
+		} else {
+			v.Valid = true
+		}
+	}
+
+	return v
+}
+
+// suggestMnemonicWords returns the words of the bip39 english wordlist closest to an invalid word.
+// A word starting with the same four letters is always suggested, since they identify a word of the list.
+// Words with the same distance are ordered by the length of the prefix they share with the invalid word.
+func suggestMnemonicWords(word string) []string {
+	word = strings.ToLower(word)
+
+	type candidate struct {
+		word     string
+		score    int
+		distance int
+		prefix   int
+	}
+
+	var candidates []candidate
+	for _, w := range wordlists.English {
+		c := candidate{
+			word:     w,
+			distance: editDistance(word, w),
+			prefix:   commonPrefixLength(word, w),
+		}
+
+		c.score = c.distance
+		if c.prefix >= mnemonicPrefixLength && c.score > 1 {
+			c.score = 1
+		}
+
+		if c.score <= maxSuggestionDistance {
+			candidates = append(candidates, c)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.score != b.score {
+			return a.score < b.score
+		}
+		if a.distance != b.distance {
+			return a.distance < b.distance
+		}
+		return a.prefix > b.prefix
+	})
+
+	suggestions := []string{}
+	for i := 0; i < len(candidates) && i < maxMnemonicSuggestions; i++ {
+		suggestions = append(suggestions, candidates[i].word)
+	}
+
+	return suggestions
+}
+
+func commonPrefixLength(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
+
+// editDistance returns the Damerau-Levenshtein distance between two words,
+// where swapping adjacent letters counts as a single edit
+func editDistance(a, b string) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			d[i][j] = min3(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] && d[i-2][j-2]+1 < d[i][j] {
+				d[i][j] = d[i-2][j-2] + 1
+			}
+		}
+	}
+
+	return d[len(a)][len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// URI: /api/v1/mnemonic/validate
+// Method: POST
+// Args: JSON Body
+func mnemonicValidate() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if r.Header.Get("Content-Type") != ContentTypeJSON {
+			resp := NewHTTPErrorResponse(http.StatusUnsupportedMediaType, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		var req MnemonicValidateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			resp := NewHTTPErrorResponse(http.StatusBadRequest, err.Error())
+			writeHTTPResponse(w, resp)
+			return
+		}
+		defer r.Body.Close()
+
+		if req.Mnemonic == "" {
+			resp := NewHTTPErrorResponse(http.StatusBadRequest, "mnemonic is required")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		writeHTTPResponse(w, HTTPResponse{
+			Data: validateMnemonic(req.Mnemonic),
+		})
+	}
+}
+
+// URI: /api/v1/mnemonic/words
+// Method: GET
+// Args:
+//  prefix: returns the words which start with prefix, all the words if not set [optional]
+func mnemonicWordsHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		prefix := strings.ToLower(strings.TrimSpace(r.FormValue("prefix")))
+
+		writeHTTPResponse(w, HTTPResponse{
+			Data: mnemonicWordsWithPrefix(prefix),
+		})
+	}
+}
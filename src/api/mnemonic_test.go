@@ -0,0 +1,333 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMnemonic(t *testing.T) {
+	cases := []struct {
+		name     string
+		mnemonic string
+		result   MnemonicValidation
+	}{
+		{
+			name:     "valid 12 words",
+			mnemonic: "cloud flower upset remain green metal below cup stem infant art thank",
+			result: MnemonicValidation{
+				Valid:     true,
+				WordCount: 12,
+			},
+		},
+
+		{
+			name:     "valid 24 words",
+			mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art",
+			result: MnemonicValidation{
+				Valid:     true,
+				WordCount: 24,
+			},
+		},
+
+		{
+			name:     "15 words",
+			mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon address",
+			result: MnemonicValidation{
+				WordCount: 15,
+				Error:     "mnemonic must have 12 or 24 words",
+			},
+		},
+
+		{
+			name:     "typos",
+			mnemonic: "cluod flower upset remian green metal below cup stem infant art thank",
+			result: MnemonicValidation{
+				WordCount: 12,
+				Error:     "mnemonic contains words which are not in the bip39 english wordlist",
+				InvalidWords: []InvalidMnemonicWord{
+					{Index: 0, Word: "cluod", Suggestions: []string{"cloud", "club", "clump"}},
+					{Index: 3, Word: "remian", Suggestions: []string{"remain", "remind", "region"}},
+				},
+			},
+		},
+
+		{
+			name:     "capitalized word",
+			mnemonic: "Cloud flower upset remain green metal below cup stem infant art thank",
+			result: MnemonicValidation{
+				WordCount: 12,
+				Error:     "mnemonic contains words which are not in the bip39 english wordlist",
+				InvalidWords: []InvalidMnemonicWord{
+					{Index: 0, Word: "Cloud", Suggestions: []string{"cloud", "loud", "clock"}},
+				},
+			},
+		},
+
+		{
+			name:     "extra whitespace",
+			mnemonic: "cloud flower upset remain green metal below cup stem infant art  thank",
+			result: MnemonicValidation{
+				WordCount: 12,
+				Error:     "mnemonic words must be separated by single spaces, without surrounding whitespace",
+			},
+		},
+
+		{
+			name:     "checksum incorrect",
+			mnemonic: "cloud flower upset remain green metal below cup stem infant art art",
+			result: MnemonicValidation{
+				WordCount: 12,
+				Error:     "mnemonic checksum is incorrect",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.result, validateMnemonic(tc.mnemonic))
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	require.Equal(t, 0, editDistance("cloud", "cloud"))
+	require.Equal(t, 1, editDistance("cluod", "cloud"))
+	require.Equal(t, 1, editDistance("clou", "cloud"))
+	require.Equal(t, 2, editDistance("cat", "cost"))
+	require.Equal(t, 3, editDistance("", "art"))
+}
+
+func TestSuggestMnemonicWords(t *testing.T) {
+	// a word starting with the same four letters is suggested even if it is far
+	require.Equal(t, []string{"abandon", "able", "again"}, suggestMnemonicWords("aban"))
+	require.Equal(t, []string{"thank", "that", "tank"}, suggestMnemonicWords("thnak"))
+	require.Equal(t, []string{}, suggestMnemonicWords("qqqqqqq"))
+}
+
+func TestMnemonicValidate(t *testing.T) {
+	cases := []struct {
+		name         string
+		method       string
+		status       int
+		contentType  string
+		httpBody     string
+		httpResponse HTTPResponse
+		result       *MnemonicValidation
+	}{
+		{
+			name:         "405",
+			method:       http.MethodGet,
+			status:       http.StatusMethodNotAllowed,
+			httpResponse: NewHTTPErrorResponse(http.StatusMethodNotAllowed, ""),
+		},
+
+		{
+			name:         "415 - Unsupported Media Type",
+			method:       http.MethodPost,
+			contentType:  ContentTypeForm,
+			status:       http.StatusUnsupportedMediaType,
+			httpResponse: NewHTTPErrorResponse(http.StatusUnsupportedMediaType, ""),
+		},
+
+		{
+			name:         "400 - no mnemonic",
+			method:       http.MethodPost,
+			contentType:  ContentTypeJSON,
+			status:       http.StatusBadRequest,
+			httpBody:     toJSON(t, MnemonicValidateRequest{}),
+			httpResponse: NewHTTPErrorResponse(http.StatusBadRequest, "mnemonic is required"),
+		},
+
+		{
+			name:        "200 - valid",
+			method:      http.MethodPost,
+			contentType: ContentTypeJSON,
+			status:      http.StatusOK,
+			httpBody:    toJSON(t, MnemonicValidateRequest{Mnemonic: "cloud flower upset remain green metal below cup stem infant art thank"}),
+			result: &MnemonicValidation{
+				Valid:     true,
+				WordCount: 12,
+			},
+		},
+
+		{
+			name:        "200 - invalid",
+			method:      http.MethodPost,
+			contentType: ContentTypeJSON,
+			status:      http.StatusOK,
+			httpBody:    toJSON(t, MnemonicValidateRequest{Mnemonic: "cloud flower"}),
+			result: &MnemonicValidation{
+				WordCount: 2,
+				Error:     "mnemonic must have 12 or 24 words",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// the mnemonic is validated without the device
+			gateway := &MockGatewayer{}
+			handler := newServerMux(defaultMuxConfig(), gateway)
+
+			rr := serveTestRequest(t, handler, tc.method, "/mnemonic/validate", tc.httpBody, map[string]string{
+				"Content-Type": tc.contentType,
+			})
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+
+			var rsp ReceivedHTTPResponse
+			err := json.NewDecoder(rr.Body).Decode(&rsp)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.httpResponse.Error, rsp.Error)
+
+			if tc.result != nil {
+				var result MnemonicValidation
+				err = json.Unmarshal(rsp.Data, &result)
+				require.NoError(t, err)
+				require.Equal(t, *tc.result, result)
+			}
+
+			gateway.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMnemonicWords(t *testing.T) {
+	cases := []struct {
+		name   string
+		method string
+		query  string
+		status int
+		words  []string
+		count  int
+	}{
+		{
+			name:   "405",
+			method: http.MethodPost,
+			status: http.StatusMethodNotAllowed,
+		},
+
+		{
+			name:   "200 - prefix",
+			method: http.MethodGet,
+			query:  "?prefix=abs",
+			status: http.StatusOK,
+			words:  []string{"absent", "absorb", "abstract", "absurd"},
+		},
+
+		{
+			name:   "200 - prefix is case insensitive",
+			method: http.MethodGet,
+			query:  "?prefix=Zo",
+			status: http.StatusOK,
+			words:  []string{"zone", "zoo"},
+		},
+
+		{
+			name:   "200 - no match",
+			method: http.MethodGet,
+			query:  "?prefix=xyz",
+			status: http.StatusOK,
+			words:  []string{},
+		},
+
+		{
+			name:   "200 - all words",
+			method: http.MethodGet,
+			status: http.StatusOK,
+			count:  2048,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+			handler := newServerMux(defaultMuxConfig(), gateway)
+
+			rr := serveTestRequest(t, handler, tc.method, "/mnemonic/words"+tc.query, "", nil)
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+
+			if tc.status != http.StatusOK {
+				return
+			}
+
+			var rsp ReceivedHTTPResponse
+			err := json.NewDecoder(rr.Body).Decode(&rsp)
+			require.NoError(t, err)
+
+			var words []string
+			err = json.Unmarshal(rsp.Data, &words)
+			require.NoError(t, err)
+
+			if tc.words != nil {
+				require.Equal(t, tc.words, words)
+			} else {
+				require.Len(t, words, tc.count)
+			}
+		})
+	}
+}
+
+func TestWordRequest(t *testing.T) {
+	cases := []struct {
+		name         string
+		word         string
+		status       int
+		httpResponse HTTPResponse
+	}{
+		{
+			name:   "200 - word in the wordlist",
+			word:   "cloud",
+			status: http.StatusOK,
+			httpResponse: HTTPResponse{
+				Data: []string{"WordRequest"},
+			},
+		},
+
+		{
+			name:   "422 - word not in the wordlist",
+			word:   "cluod",
+			status: http.StatusUnprocessableEntity,
+			httpResponse: HTTPResponse{
+				Error: &HTTPError{
+					Code:    http.StatusUnprocessableEntity,
+					Message: "word is not in the bip39 english wordlist",
+				},
+				Data: InvalidMnemonicWord{
+					Word:        "cluod",
+					Suggestions: []string{"cloud", "club", "clump"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+			gateway.On("WordAck", tc.word).Return(wire.Message{
+				Kind: uint16(messages.MessageType_MessageType_WordRequest),
+			}, nil)
+
+			handler := newServerMux(defaultMuxConfig(), gateway)
+
+			rr := serveTestRequest(t, handler, http.MethodPost, "/intermediate/word", toJSON(t, WordRequest{Word: tc.word}), nil)
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+
+			var rsp ReceivedHTTPResponse
+			err := json.NewDecoder(rr.Body).Decode(&rsp)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.httpResponse.Error, rsp.Error)
+			require.JSONEq(t, toJSON(t, tc.httpResponse.Data), string(rsp.Data))
+
+			if tc.status != http.StatusOK {
+				gateway.AssertNotCalled(t, "WordAck", tc.word)
+			}
+		})
+	}
+}
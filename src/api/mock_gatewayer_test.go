@@ -276,6 +276,27 @@ func (_m *MockGatewayer) GetFeatures() (wire.Message, error) {
 	return r0, r1
 }
 
+// LoadDevice provides a mock function with given fields: mnemonic, pin, passphraseProtection, label
+func (_m *MockGatewayer) LoadDevice(mnemonic string, pin string, passphraseProtection bool, label string) (wire.Message, error) {
+	ret := _m.Called(mnemonic, pin, passphraseProtection, label)
+
+	var r0 wire.Message
+	if rf, ok := ret.Get(0).(func(string, string, bool, string) wire.Message); ok {
+		r0 = rf(mnemonic, pin, passphraseProtection, label)
+	} else {
+		r0 = ret.Get(0).(wire.Message)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, bool, string) error); ok {
+		r1 = rf(mnemonic, pin, passphraseProtection, label)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PassphraseAck provides a mock function with given fields: passphrase
 func (_m *MockGatewayer) PassphraseAck(passphrase string) (wire.Message, error) {
 	ret := _m.Called(passphrase)
@@ -297,6 +318,27 @@ func (_m *MockGatewayer) PassphraseAck(passphrase string) (wire.Message, error)
 	return r0, r1
 }
 
+// PassphraseStateAck provides a mock function with given fields:
+func (_m *MockGatewayer) PassphraseStateAck() (wire.Message, error) {
+	ret := _m.Called()
+
+	var r0 wire.Message
+	if rf, ok := ret.Get(0).(func() wire.Message); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(wire.Message)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PinMatrixAck provides a mock function with given fields: p
 func (_m *MockGatewayer) PinMatrixAck(p string) (wire.Message, error) {
 	ret := _m.Called(p)
@@ -339,6 +381,41 @@ func (_m *MockGatewayer) Recovery(wordCount uint32, usePassphrase *bool, dryRun
 	return r0, r1
 }
 
+// ResetDevice provides a mock function with given fields: options
+func (_m *MockGatewayer) ResetDevice(options ResetDeviceOptions) (wire.Message, error) {
+	ret := _m.Called(options)
+
+	var r0 wire.Message
+	if rf, ok := ret.Get(0).(func(ResetDeviceOptions) wire.Message); ok {
+		r0 = rf(options)
+	} else {
+		r0 = ret.Get(0).(wire.Message)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ResetDeviceOptions) error); ok {
+		r1 = rf(options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveDeviceEntropyInFile provides a mock function with given fields: outFile, entropyBytes, getEntropyMsgBuilder
+func (_m *MockGatewayer) SaveDeviceEntropyInFile(outFile string, entropyBytes uint32, getEntropyMsgBuilder func(uint32) ([][64]byte, error)) error {
+	ret := _m.Called(outFile, entropyBytes, getEntropyMsgBuilder)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, uint32, func(uint32) ([][64]byte, error)) error); ok {
+		r0 = rf(outFile, entropyBytes, getEntropyMsgBuilder)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetAutoPressButton provides a mock function with given fields: simulateButtonPress, simulateButtonType
 func (_m *MockGatewayer) SetAutoPressButton(simulateButtonPress bool, simulateButtonType skywallet.ButtonType) error {
 	ret := _m.Called(simulateButtonPress, simulateButtonType)
@@ -0,0 +1,122 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// nodeRequestTimeout is the timeout of requests to the skycoin node
+	nodeRequestTimeout = time.Second * 30
+)
+
+// NodeClient queries the REST API of a skycoin node
+type NodeClient struct {
+	addr       string
+	httpClient *http.Client
+}
+
+// NewNodeClient creates a NodeClient for the node at addr, e.g. http://127.0.0.1:6420
+func NewNodeClient(addr string) *NodeClient {
+	return &NodeClient{
+		addr: strings.TrimRight(addr, "/"),
+		httpClient: &http.Client{
+			Timeout: nodeRequestTimeout,
+		},
+	}
+}
+
+// NodeBalance is the balance of an address as returned by the node
+type NodeBalance struct {
+	Coins uint64 `json:"coins"`
+	Hours uint64 `json:"hours"`
+}
+
+// nodeBalanceResponse is the response of GET /api/v1/balance
+type nodeBalanceResponse struct {
+	Addresses map[string]struct {
+		Confirmed NodeBalance `json:"confirmed"`
+	} `json:"addresses"`
+}
+
+// nodeTransaction is a transaction as returned by GET /api/v1/transactions
+type nodeTransaction struct {
+	Txn struct {
+		Outputs []struct {
+			Address string `json:"dst"`
+		} `json:"outputs"`
+	} `json:"txn"`
+}
+
+func (c *NodeClient) get(endpoint string, params url.Values, v interface{}) error {
+	u := fmt.Sprintf("%s%s?%s", c.addr, endpoint, params.Encode())
+
+	resp, err := c.httpClient.Get(u)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body) // nolint: errcheck
+		return fmt.Errorf("node returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// Balances returns the confirmed balance of each address
+func (c *NodeClient) Balances(addrs []string) (map[string]NodeBalance, error) {
+	balances := make(map[string]NodeBalance, len(addrs))
+	if len(addrs) == 0 {
+		return balances, nil
+	}
+
+	var resp nodeBalanceResponse
+	if err := c.get("/api/v1/balance", url.Values{
+		"addrs": []string{strings.Join(addrs, ",")},
+	}, &resp); err != nil {
+		return nil, err
+	}
+
+	for addr, b := range resp.Addresses {
+		balances[addr] = b.Confirmed
+	}
+
+	return balances, nil
+}
+
+// UsedAddresses returns the addresses which received coins in any transaction known to the node
+func (c *NodeClient) UsedAddresses(addrs []string) (map[string]bool, error) {
+	used := make(map[string]bool, len(addrs))
+	if len(addrs) == 0 {
+		return used, nil
+	}
+
+	var txns []nodeTransaction
+	if err := c.get("/api/v1/transactions", url.Values{
+		"addrs": []string{strings.Join(addrs, ",")},
+	}, &txns); err != nil {
+		return nil, err
+	}
+
+	requested := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		requested[addr] = struct{}{}
+	}
+
+	for _, txn := range txns {
+		for _, o := range txn.Txn.Outputs {
+			if _, ok := requested[o.Address]; ok {
+				used[o.Address] = true
+			}
+		}
+	}
+
+	return used, nil
+}
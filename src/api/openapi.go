@@ -0,0 +1,198 @@
+package api
+
+//go:generate go run gen_openapi_spec.go
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ErrorTypeInvalidRequest is the type of the error returned when a request body does not match the OpenAPI spec
+const ErrorTypeInvalidRequest = "invalid_request"
+
+// openAPISpec is the OpenAPI spec of /api/v1, embedded from swagger.yml
+type openAPISpec struct {
+	// json is the spec served by /api/v1/openapi.json
+	json json.RawMessage
+	// bodies are the schemas of the request bodies, by endpoint and method
+	bodies map[string]map[string]*spec.Schema
+}
+
+var openAPI *openAPISpec
+
+func init() {
+	var err error
+	openAPI, err = loadOpenAPISpec(openAPISpecYAML)
+	if err != nil {
+		logger.Panic(err)
+	}
+}
+
+// loadOpenAPISpec parses a YAML OpenAPI spec and expands the body schemas of its operations
+func loadOpenAPISpec(yamlSpec string) (*openAPISpec, error) {
+	yamlDoc, err := swag.BytesToYAMLDoc([]byte(yamlSpec))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := swag.YAMLToJSON(yamlDoc)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := loads.Analyzed(data, "")
+	if err != nil {
+		return nil, err
+	}
+
+	expanded, err := doc.Expanded()
+	if err != nil {
+		return nil, err
+	}
+
+	bodies := make(map[string]map[string]*spec.Schema)
+	for path, item := range expanded.Spec().Paths.Paths {
+		operations := map[string]*spec.Operation{
+			http.MethodPost:   item.Post,
+			http.MethodPut:    item.Put,
+			http.MethodDelete: item.Delete,
+		}
+
+		for method, op := range operations {
+			if op == nil {
+				continue
+			}
+
+			for _, p := range op.Parameters {
+				if p.In != "body" || p.Schema == nil {
+					continue
+				}
+
+				if err := setExtensions(p.Schema); err != nil {
+					return nil, err
+				}
+
+				if bodies[path] == nil {
+					bodies[path] = make(map[string]*spec.Schema)
+				}
+				bodies[path][method] = p.Schema
+			}
+		}
+	}
+
+	return &openAPISpec{
+		json:   doc.Raw(),
+		bodies: bodies,
+	}, nil
+}
+
+// setExtensions applies the Swagger 2.0 extensions of the schemas which validate needs:
+// x-nullable marks a schema as nullable, which is the only way validate accepts a null value.
+// x-oneOf holds oneOf schemas, which Swagger 2.0 does not allow.
+func setExtensions(s *spec.Schema) error {
+	if s == nil {
+		return nil
+	}
+
+	if nullable, ok := s.Extensions.GetBool("x-nullable"); ok && nullable {
+		s.Nullable = true
+	}
+
+	if oneOf, ok := s.Extensions["x-oneOf"]; ok {
+		data, err := json.Marshal(oneOf)
+		if err != nil {
+			return err
+		}
+
+		if err := json.Unmarshal(data, &s.OneOf); err != nil {
+			return fmt.Errorf("invalid x-oneOf: %v", err)
+		}
+	}
+
+	for name, property := range s.Properties {
+		if err := setExtensions(&property); err != nil {
+			return err
+		}
+		s.Properties[name] = property
+	}
+
+	if s.Items != nil {
+		if err := setExtensions(s.Items.Schema); err != nil {
+			return err
+		}
+		for i := range s.Items.Schemas {
+			if err := setExtensions(&s.Items.Schemas[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateRequestBody validates the JSON body of the requests to an endpoint against its schema in the OpenAPI spec.
+// A body which is not valid JSON is left to the handler, which returns its decoding error.
+func validateRequestBody(endpoint string, handler http.Handler) http.Handler {
+	schemas := openAPI.bodies[endpoint]
+	if len(schemas) == 0 {
+		return handler
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		schema, ok := schemas[r.Method]
+		if !ok || r.Body == nil || r.Header.Get("Content-Type") != ContentTypeJSON {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		r.Body.Close() // nolint: errcheck
+		if err != nil {
+			resp := NewHTTPErrorResponse(http.StatusBadRequest, err.Error())
+			writeHTTPResponse(w, resp)
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		var data interface{}
+		if err := json.Unmarshal(body, &data); err != nil {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		if err := validate.AgainstSchema(schema, data, strfmt.Default); err != nil {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, err.Error())
+			resp.Error.Type = ErrorTypeInvalidRequest
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// URI: /api/v1/openapi.json
+// Method: GET
+func openAPIHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		w.Header().Set("Content-Type", ContentTypeJSON)
+		if _, err := w.Write(openAPI.json); err != nil {
+			logger.WithError(err).Error("http Write failed")
+		}
+	}
+}
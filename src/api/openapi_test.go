@@ -0,0 +1,119 @@
+package api
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newInvalidRequestResponse(msg string) HTTPResponse {
+	return newTypedHTTPErrorResponse(http.StatusUnprocessableEntity, ErrorTypeInvalidRequest, "validation failure list:\n"+msg)
+}
+
+func TestOpenAPISpecGenerated(t *testing.T) {
+	data, err := ioutil.ReadFile("../../swagger.yml")
+	require.NoError(t, err)
+	require.Equal(t, string(data), openAPISpecYAML, "openapi_spec.go is out of date, run go generate ./src/api")
+}
+
+func TestOpenAPIHandler(t *testing.T) {
+	cases := []struct {
+		name   string
+		method string
+		status int
+	}{
+		{
+			name:   "405",
+			method: http.MethodPost,
+			status: http.StatusMethodNotAllowed,
+		},
+
+		{
+			name:   "200",
+			method: http.MethodGet,
+			status: http.StatusOK,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+			handler := newServerMux(defaultMuxConfig(), gateway)
+
+			rr := serveTestRequest(t, handler, tc.method, "/openapi.json", "", nil)
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+			if tc.status != http.StatusOK {
+				return
+			}
+
+			require.Equal(t, ContentTypeJSON, rr.Header().Get("Content-Type"))
+
+			var doc struct {
+				Swagger  string                     `json:"swagger"`
+				BasePath string                     `json:"basePath"`
+				Paths    map[string]json.RawMessage `json:"paths"`
+			}
+			err := json.NewDecoder(rr.Body).Decode(&doc)
+			require.NoError(t, err)
+			require.Equal(t, "2.0", doc.Swagger)
+			require.Equal(t, "/api/v1", doc.BasePath)
+			require.Contains(t, doc.Paths, "/openapi.json")
+		})
+	}
+}
+
+func TestValidateRequestBody(t *testing.T) {
+	cases := []struct {
+		name         string
+		contentType  string
+		httpBody     string
+		status       int
+		httpResponse HTTPResponse
+	}{
+		{
+			name:         "422 - wrong type",
+			contentType:  ContentTypeJSON,
+			httpBody:     `{"address_n":"1"}`,
+			status:       http.StatusUnprocessableEntity,
+			httpResponse: newInvalidRequestResponse("address_n in body must be of type integer: \"string\""),
+		},
+
+		{
+			name:         "400 - malformed body is left to the handler",
+			contentType:  ContentTypeJSON,
+			httpBody:     `{"address_n":`,
+			status:       http.StatusBadRequest,
+			httpResponse: NewHTTPErrorResponse(http.StatusBadRequest, "unexpected EOF"),
+		},
+
+		{
+			name:         "415 - not json is left to the handler",
+			contentType:  "text/plain",
+			httpBody:     `{"address_n":"1"}`,
+			status:       http.StatusUnsupportedMediaType,
+			httpResponse: NewHTTPErrorResponse(http.StatusUnsupportedMediaType, ""),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+			handler := newServerMux(defaultMuxConfig(), gateway)
+
+			rr := serveTestRequest(t, handler, http.MethodPost, "/generate_addresses", tc.httpBody, map[string]string{
+				"Content-Type": tc.contentType,
+			})
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+
+			var rsp ReceivedHTTPResponse
+			err := json.NewDecoder(rr.Body).Decode(&rsp)
+			require.NoError(t, err)
+			require.Equal(t, tc.httpResponse.Error, rsp.Error)
+
+			gateway.AssertExpectations(t)
+		})
+	}
+}
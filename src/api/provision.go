@@ -0,0 +1,479 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+
+	skyWallet "github.com/SkycoinProject/hardware-wallet-go/src/skywallet"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+)
+
+// Statuses of a provisioning and of its steps
+const (
+	provisionNone        = "none"
+	provisionPending     = "pending"
+	provisionInProgress  = "in_progress"
+	provisionDone        = "done"
+	provisionFailed      = "failed"
+	provisionInterrupted = "interrupted"
+)
+
+// Steps of a provisioning, in the order they run
+const (
+	provisionStepWipe             = "wipe"
+	provisionStepGenerateMnemonic = "generate_mnemonic"
+	provisionStepBackup           = "backup"
+	provisionStepConfigurePinCode = "configure_pin_code"
+	provisionStepApplySettings    = "apply_settings"
+)
+
+// ProvisionProfile is request data for POST /api/v1/provision
+type ProvisionProfile struct {
+	WordCount      uint32 `json:"word_count"`
+	UsePassphrase  bool   `json:"use_passphrase"`
+	Label          string `json:"label"`
+	Language       string `json:"language"`
+	PinRequired    bool   `json:"pin_required"`
+	BackupRequired bool   `json:"backup_required"`
+}
+
+// steps returns the steps which set up a device with the profile
+func (p ProvisionProfile) steps() []ProvisionStep {
+	names := []string{provisionStepWipe, provisionStepGenerateMnemonic}
+	if p.BackupRequired {
+		names = append(names, provisionStepBackup)
+	}
+	if p.PinRequired {
+		names = append(names, provisionStepConfigurePinCode)
+	}
+	if p.Label != "" || p.Language != "" {
+		names = append(names, provisionStepApplySettings)
+	}
+
+	steps := make([]ProvisionStep, len(names))
+	for i, name := range names {
+		steps[i] = ProvisionStep{
+			Name:   name,
+			Status: provisionPending,
+		}
+	}
+	return steps
+}
+
+// ProvisionStep is a step of a provisioning
+type ProvisionStep struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	// Message is the firmware message or the error of the step
+	Message string `json:"message,omitempty"`
+}
+
+// Provisioning is the status of the last provisioning, returned by /api/v1/provision
+type Provisioning struct {
+	Status  string            `json:"status"`
+	Profile *ProvisionProfile `json:"profile,omitempty"`
+	Steps   []ProvisionStep   `json:"steps"`
+}
+
+// provisioning runs the steps of a provisioning profile on the device. The provisioning pauses when
+// the device asks for user input, the firmware response of each intermediate request resumes it.
+// A failed or interrupted provisioning can be resumed from the step which did not complete.
+type provisioning struct {
+	auditLog    *AuditLog
+	addressBook *AddressBook
+
+	lock    sync.Mutex
+	status  Provisioning
+	current int
+}
+
+func newProvisioning(auditLog *AuditLog, addressBook *AddressBook) *provisioning {
+	return &provisioning{
+		auditLog:    auditLog,
+		addressBook: addressBook,
+		status: Provisioning{
+			Status: provisionNone,
+			Steps:  []ProvisionStep{},
+		},
+	}
+}
+
+// get returns the status of the last provisioning
+func (p *provisioning) get() Provisioning {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.copyStatus()
+}
+
+func (p *provisioning) copyStatus() Provisioning {
+	s := p.status
+	s.Steps = append([]ProvisionStep{}, p.status.Steps...)
+	return s
+}
+
+// pending returns true if a provisioning is waiting for user input
+func (p *provisioning) pending() bool {
+	return p.get().Status == provisionInProgress
+}
+
+// begin starts tracking a provisioning with a profile
+func (p *provisioning) begin(profile ProvisionProfile) {
+	p.lock.Lock()
+	p.status = Provisioning{
+		Status:  provisionInProgress,
+		Profile: &profile,
+		Steps:   profile.steps(),
+	}
+	p.current = 0
+	p.lock.Unlock()
+}
+
+// resumable restarts a failed or interrupted provisioning from the step which did not complete.
+// It returns false if there is no such provisioning.
+func (p *provisioning) resumable() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.status.Status != provisionFailed && p.status.Status != provisionInterrupted {
+		return false
+	}
+
+	p.status.Status = provisionInProgress
+	p.status.Steps[p.current].Status = provisionPending
+	p.status.Steps[p.current].Message = ""
+	return true
+}
+
+// fail records a daemon side error of the current step
+func (p *provisioning) fail(message string) {
+	p.end(provisionFailed, message)
+}
+
+func (p *provisioning) resumeFlow(w http.ResponseWriter, r *http.Request, gateway Gatewayer, msg wire.Message) bool {
+	return p.respond(w, r, gateway, msg)
+}
+
+func (p *provisioning) failFlow(err error) {
+	p.fail(err.Error())
+}
+
+// cancelFlow stops the provisioning at the cancelled step
+func (p *provisioning) cancelFlow(gateway Gatewayer, msg wire.Message) {
+	p.stop(msg)
+}
+
+// interrupt records the provisioning as interrupted, it was replaced on the device by a new flow
+func (p *provisioning) interrupt() {
+	p.end(provisionInterrupted, "")
+}
+
+func (p *provisioning) end(status, message string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.status.Status != provisionInProgress {
+		return
+	}
+
+	p.status.Status = status
+	p.status.Steps[p.current].Status = status
+	p.status.Steps[p.current].Message = message
+}
+
+// stop records the final firmware response of the current step without running the next steps,
+// as when the current step is cancelled
+func (p *provisioning) stop(msg wire.Message) {
+	if isIntermediateMessage(msg) || !p.pending() {
+		return
+	}
+
+	if _, ok := p.finishStep(msg); !ok {
+		return
+	}
+
+	// the next steps run when the provisioning is resumed
+	p.interrupt()
+}
+
+// finishStep records the firmware response of the current step. It returns the message
+// of a failed step and false if the step failed.
+func (p *provisioning) finishStep(msg wire.Message) (string, bool) {
+	var message string
+	var ok bool
+	switch msg.Kind {
+	case uint16(messages.MessageType_MessageType_Success):
+		var err error
+		message, err = skyWallet.DecodeSuccessMsg(msg)
+		ok = err == nil
+		if err != nil {
+			message = err.Error()
+		}
+	case uint16(messages.MessageType_MessageType_Failure):
+		failureMsg, err := skyWallet.DecodeFailMsg(msg)
+		if err != nil {
+			failureMsg = err.Error()
+		}
+		message = failureMsg
+	default:
+		message = fmt.Sprintf("received unexpected response message type: %s", messages.MessageType(msg.Kind))
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.status.Status != provisionInProgress {
+		return message, false
+	}
+
+	step := &p.status.Steps[p.current]
+	step.Message = message
+	if !ok {
+		step.Status = provisionFailed
+		p.status.Status = provisionFailed
+		return message, false
+	}
+
+	step.Status = provisionDone
+	p.current++
+	if p.current == len(p.status.Steps) {
+		p.current--
+		p.status.Status = provisionDone
+	}
+
+	return message, true
+}
+
+// runStep sends the request of the current step to the device
+func (p *provisioning) runStep(r *http.Request, gateway Gatewayer) (wire.Message, error) {
+	p.lock.Lock()
+	step := &p.status.Steps[p.current]
+	step.Status = provisionInProgress
+	name := step.Name
+	profile := *p.status.Profile
+	p.lock.Unlock()
+
+	switch name {
+	case provisionStepWipe:
+		auditEntry := p.auditLog.begin(r, gateway, "wipe", nil)
+		addressBookDone := p.addressBook.reset(gateway)
+
+		msg, err := gateway.Wipe()
+		if err != nil {
+			p.auditLog.finishWithResult(auditEntry, auditResultError, err.Error())
+			return wire.Message{}, err
+		}
+
+		p.addressBook.finish(gateway, addressBookDone, msg)
+		p.auditLog.finish(auditEntry, msg)
+		return msg, nil
+	case provisionStepGenerateMnemonic:
+		return gateway.GenerateMnemonic(profile.WordCount, profile.UsePassphrase)
+	case provisionStepBackup:
+		return gateway.Backup()
+	case provisionStepConfigurePinCode:
+		return gateway.ChangePin(newBoolPtr(false))
+	case provisionStepApplySettings:
+		// passphrase protection is set when the mnemonic is generated
+		return gateway.ApplySettings(nil, profile.Label, profile.Language)
+	default:
+		return wire.Message{}, fmt.Errorf("unknown provisioning step %s", name)
+	}
+}
+
+// run runs the steps from the current one until the device asks for user input,
+// a step fails or the provisioning is done. It returns the intermediate message
+// of the device, or the response of the provisioning if there is none.
+func (p *provisioning) run(r *http.Request, gateway Gatewayer) (*wire.Message, HTTPResponse) {
+	for {
+		msg, err := p.runStep(r, gateway)
+		if err != nil {
+			p.fail(err.Error())
+			logger.Errorf("provision failed: %s", err.Error())
+			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+			resp.Data = p.get()
+			return nil, resp
+		}
+
+		intermediate, resp, next := p.stepResponse(msg)
+		if !next {
+			return intermediate, resp
+		}
+	}
+}
+
+// stepResponse handles the firmware response of the current step.
+// It returns true if the next step should run.
+func (p *provisioning) stepResponse(msg wire.Message) (*wire.Message, HTTPResponse, bool) {
+	if isIntermediateMessage(msg) {
+		return &msg, HTTPResponse{}, false
+	}
+
+	message, ok := p.finishStep(msg)
+	status := p.get()
+
+	if !ok {
+		resp := NewHTTPErrorResponse(http.StatusConflict, message)
+		resp.Data = status
+		return nil, resp, false
+	}
+
+	if status.Status == provisionDone {
+		return nil, HTTPResponse{Data: status}, false
+	}
+
+	return nil, HTTPResponse{}, true
+}
+
+// writeProvisionResponse writes the intermediate message of the device or the response of the provisioning
+func writeProvisionResponse(w http.ResponseWriter, intermediate *wire.Message, resp HTTPResponse) {
+	if intermediate != nil {
+		HandleFirmwareResponseMessages(w, *intermediate)
+		return
+	}
+
+	writeHTTPResponse(w, resp)
+}
+
+// respond handles the firmware response of an intermediate request. If a provisioning is pending,
+// its next steps are run, the response is written and true is returned.
+func (p *provisioning) respond(w http.ResponseWriter, r *http.Request, gateway Gatewayer, msg wire.Message) bool {
+	if !p.pending() {
+		return false
+	}
+
+	intermediate, resp, next := p.stepResponse(msg)
+	if next {
+		intermediate, resp = p.run(r, gateway)
+	}
+
+	writeProvisionResponse(w, intermediate, resp)
+	return true
+}
+
+// runProvisioning runs the provisioning steps for a request which starts or resumes it
+func runProvisioning(w http.ResponseWriter, r *http.Request, gateway Gatewayer, provisions *provisioning) {
+	var intermediate *wire.Message
+	var resp HTTPResponse
+	retCH := make(chan int)
+	ctx := r.Context()
+
+	go func() {
+		intermediate, resp = provisions.run(r, gateway)
+		retCH <- 1
+	}()
+
+	select {
+	case <-retCH:
+		writeProvisionResponse(w, intermediate, resp)
+	case <-ctx.Done():
+		provisions.interrupt()
+		disConnErr := gateway.Disconnect()
+		if disConnErr != nil {
+			resp := NewHTTPErrorResponse(http.StatusInternalServerError, disConnErr.Error())
+			writeHTTPResponse(w, resp)
+		} else {
+			resp := NewHTTPErrorResponse(499, "Client Closed Request")
+			writeHTTPResponse(w, resp)
+		}
+	}
+}
+
+// provision sets up a device with a profile: the device is wiped, a new mnemonic is generated,
+// and the backup, pin code and settings steps of the profile are run. The provisioning pauses when
+// the device asks for user input, which is answered with the intermediate endpoints.
+// URI: /api/v1/provision
+// Method: GET, POST
+// Args: JSON Body for POST
+func provision(gateway Gatewayer, provisions *provisioning, flows *deviceFlows) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			writeHTTPResponse(w, HTTPResponse{
+				Data: provisions.get(),
+			})
+			return
+		case http.MethodPost:
+		default:
+			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if r.Header.Get("Content-Type") != ContentTypeJSON {
+			resp := NewHTTPErrorResponse(http.StatusUnsupportedMediaType, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		var profile ProvisionProfile
+		if err := json.NewDecoder(r.Body).Decode(&profile); err != nil {
+			resp := NewHTTPErrorResponse(http.StatusBadRequest, err.Error())
+			writeHTTPResponse(w, resp)
+			return
+		}
+		defer r.Body.Close()
+
+		if profile.WordCount != 12 && profile.WordCount != 24 {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, "word_count must be 12 or 24")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		// starting the provisioning replaces any flow waiting for user input
+		flows.interrupt(provisions)
+
+		// for integration tests
+		if autoPressEmulatorButtons {
+			err := gateway.SetAutoPressButton(true, skyWallet.ButtonRight)
+			if err != nil {
+				logger.Error("provision failed: %s", err.Error())
+				resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+				writeHTTPResponse(w, resp)
+				return
+			}
+		}
+
+		provisions.begin(profile)
+		runProvisioning(w, r, gateway, provisions)
+	}
+}
+
+// provisionResume resumes a failed or interrupted provisioning from the step which did not complete
+// URI: /api/v1/provision/resume
+// Method: POST
+func provisionResume(gateway Gatewayer, provisions *provisioning, flows *deviceFlows) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if !provisions.resumable() {
+			resp := NewHTTPErrorResponse(http.StatusConflict, "there is no failed or interrupted provisioning to resume")
+			resp.Data = provisions.get()
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		flows.interrupt(provisions)
+
+		// for integration tests
+		if autoPressEmulatorButtons {
+			err := gateway.SetAutoPressButton(true, skyWallet.ButtonRight)
+			if err != nil {
+				provisions.fail(err.Error())
+				logger.Error("provisionResume failed: %s", err.Error())
+				resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+				writeHTTPResponse(w, resp)
+				return
+			}
+		}
+
+		runProvisioning(w, r, gateway, provisions)
+	}
+}
@@ -0,0 +1,304 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+	"github.com/stretchr/testify/require"
+)
+
+var pinMatrixRequestWireMessage = wire.Message{
+	Kind: uint16(messages.MessageType_MessageType_PinMatrixRequest),
+}
+
+func postProvision(t *testing.T, handler http.Handler, endpoint string, profile *ProvisionProfile) (int, ReceivedHTTPResponse) {
+	body := ""
+	if profile != nil {
+		body = toJSON(t, profile)
+	}
+
+	rr := serveTestRequest(t, handler, http.MethodPost, endpoint, body, map[string]string{
+		"Content-Type": ContentTypeJSON,
+	})
+
+	var rsp ReceivedHTTPResponse
+	err := json.NewDecoder(rr.Body).Decode(&rsp)
+	require.NoError(t, err)
+	return rr.Code, rsp
+}
+
+func getProvisioning(t *testing.T, handler http.Handler) Provisioning {
+	rr := serveTestRequest(t, handler, http.MethodGet, "/provision", "", nil)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	var rsp ReceivedHTTPResponse
+	err := json.NewDecoder(rr.Body).Decode(&rsp)
+	require.NoError(t, err)
+
+	var p Provisioning
+	err = json.Unmarshal(rsp.Data, &p)
+	require.NoError(t, err)
+	return p
+}
+
+func TestProvisionValidation(t *testing.T) {
+	cases := []struct {
+		name         string
+		method       string
+		contentType  string
+		httpBody     string
+		status       int
+		httpResponse HTTPResponse
+	}{
+		{
+			name:         "405",
+			method:       http.MethodPut,
+			status:       http.StatusMethodNotAllowed,
+			httpResponse: NewHTTPErrorResponse(http.StatusMethodNotAllowed, ""),
+		},
+
+		{
+			name:         "415 - Unsupported Media Type",
+			method:       http.MethodPost,
+			contentType:  ContentTypeForm,
+			status:       http.StatusUnsupportedMediaType,
+			httpResponse: NewHTTPErrorResponse(http.StatusUnsupportedMediaType, ""),
+		},
+
+		{
+			name:         "422 - invalid word count",
+			method:       http.MethodPost,
+			contentType:  ContentTypeJSON,
+			httpBody:     toJSON(t, ProvisionProfile{WordCount: 18}),
+			status:       http.StatusUnprocessableEntity,
+			httpResponse: newInvalidRequestResponse("word_count in body should be one of [12 24]"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+			handler := newServerMux(defaultMuxConfig(), gateway)
+
+			rr := serveTestRequest(t, handler, tc.method, "/provision", tc.httpBody, map[string]string{
+				"Content-Type": tc.contentType,
+			})
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+
+			var rsp ReceivedHTTPResponse
+			err := json.NewDecoder(rr.Body).Decode(&rsp)
+			require.NoError(t, err)
+			require.Equal(t, tc.httpResponse.Error, rsp.Error)
+
+			require.Equal(t, Provisioning{
+				Status: provisionNone,
+				Steps:  []ProvisionStep{},
+			}, getProvisioning(t, handler))
+		})
+	}
+}
+
+func TestProvisionSteps(t *testing.T) {
+	require.Equal(t, []ProvisionStep{
+		{Name: provisionStepWipe, Status: provisionPending},
+		{Name: provisionStepGenerateMnemonic, Status: provisionPending},
+	}, ProvisionProfile{WordCount: 12}.steps())
+
+	require.Equal(t, []ProvisionStep{
+		{Name: provisionStepWipe, Status: provisionPending},
+		{Name: provisionStepGenerateMnemonic, Status: provisionPending},
+		{Name: provisionStepBackup, Status: provisionPending},
+		{Name: provisionStepConfigurePinCode, Status: provisionPending},
+		{Name: provisionStepApplySettings, Status: provisionPending},
+	}, ProvisionProfile{
+		WordCount:      24,
+		Language:       "english",
+		PinRequired:    true,
+		BackupRequired: true,
+	}.steps())
+}
+
+func TestProvision(t *testing.T) {
+	profile := ProvisionProfile{
+		WordCount:      12,
+		UsePassphrase:  true,
+		Label:          "Unit 42",
+		PinRequired:    true,
+		BackupRequired: true,
+	}
+
+	gateway := &MockGatewayer{}
+	gateway.On("Wipe").Return(buttonRequestMessage, nil)
+	gateway.On("ButtonAck").Return(testSuccessMessage(t, "Device wiped"), nil).Once()
+	gateway.On("GenerateMnemonic", uint32(12), true).Return(buttonRequestMessage, nil)
+	gateway.On("ButtonAck").Return(testSuccessMessage(t, "Mnemonic successfully configured"), nil).Once()
+	gateway.On("Backup").Return(buttonRequestMessage, nil)
+	gateway.On("ButtonAck").Return(testSuccessMessage(t, "Device backed up!"), nil).Once()
+	gateway.On("ChangePin", newBoolPtr(false)).Return(pinMatrixRequestWireMessage, nil)
+	gateway.On("PinMatrixAck", "123").Return(testSuccessMessage(t, "PIN changed"), nil)
+	gateway.On("ApplySettings", (*bool)(nil), "Unit 42", "").Return(testSuccessMessage(t, "Settings applied"), nil)
+
+	handler := newServerMux(defaultMuxConfig(), gateway)
+
+	status, rsp := postProvision(t, handler, "/provision", &profile)
+	require.Equal(t, http.StatusOK, status)
+	require.JSONEq(t, `["ButtonRequest"]`, string(rsp.Data))
+
+	p := getProvisioning(t, handler)
+	require.Equal(t, provisionInProgress, p.Status)
+	require.Equal(t, &profile, p.Profile)
+	require.Equal(t, []ProvisionStep{
+		{Name: provisionStepWipe, Status: provisionInProgress},
+		{Name: provisionStepGenerateMnemonic, Status: provisionPending},
+		{Name: provisionStepBackup, Status: provisionPending},
+		{Name: provisionStepConfigurePinCode, Status: provisionPending},
+		{Name: provisionStepApplySettings, Status: provisionPending},
+	}, p.Steps)
+
+	// each confirmation completes a step and starts the next one
+	for _, step := range []string{provisionStepGenerateMnemonic, provisionStepBackup} {
+		rr := serveTestRequest(t, handler, http.MethodPost, "/intermediate/button", "", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.JSONEq(t, `{"data":["ButtonRequest"],"intermediate":{"kind":"button","operation":"provision"}}`, rr.Body.String())
+
+		p = getProvisioning(t, handler)
+		for _, s := range p.Steps {
+			if s.Name == step {
+				require.Equal(t, provisionInProgress, s.Status)
+			}
+		}
+	}
+
+	rr := serveTestRequest(t, handler, http.MethodPost, "/intermediate/button", "", nil)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	require.JSONEq(t, `{"data":["PinMatrixRequest"],"intermediate":{"kind":"pin_matrix","operation":"provision"}}`, rr.Body.String())
+
+	rr = serveTestRequest(t, handler, http.MethodPost, "/intermediate/pin_matrix", toJSON(t, PinMatrixRequest{Pin: "123"}), nil)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	var final ReceivedHTTPResponse
+	err := json.NewDecoder(rr.Body).Decode(&final)
+	require.NoError(t, err)
+	require.Nil(t, final.Error)
+
+	expected := Provisioning{
+		Status:  provisionDone,
+		Profile: &profile,
+		Steps: []ProvisionStep{
+			{Name: provisionStepWipe, Status: provisionDone, Message: "Device wiped"},
+			{Name: provisionStepGenerateMnemonic, Status: provisionDone, Message: "Mnemonic successfully configured"},
+			{Name: provisionStepBackup, Status: provisionDone, Message: "Device backed up!"},
+			{Name: provisionStepConfigurePinCode, Status: provisionDone, Message: "PIN changed"},
+			{Name: provisionStepApplySettings, Status: provisionDone, Message: "Settings applied"},
+		},
+	}
+
+	err = json.Unmarshal(final.Data, &p)
+	require.NoError(t, err)
+	require.Equal(t, expected, p)
+	require.Equal(t, expected, getProvisioning(t, handler))
+
+	gateway.AssertExpectations(t)
+}
+
+func TestProvisionResume(t *testing.T) {
+	profile := ProvisionProfile{
+		WordCount:      24,
+		BackupRequired: true,
+	}
+
+	gateway := &MockGatewayer{}
+	gateway.On("Wipe").Return(testSuccessMessage(t, "Device wiped"), nil).Once()
+	gateway.On("GenerateMnemonic", uint32(24), false).Return(testSuccessMessage(t, "Mnemonic successfully configured"), nil).Once()
+	gateway.On("Backup").Return(testFailureMessage(t, "Action cancelled by user"), nil).Once()
+	gateway.On("Backup").Return(testSuccessMessage(t, "Device backed up!"), nil).Once()
+
+	handler := newServerMux(defaultMuxConfig(), gateway)
+
+	// nothing to resume
+	status, rsp := postProvision(t, handler, "/provision/resume", nil)
+	require.Equal(t, http.StatusConflict, status)
+	require.Equal(t, "there is no failed or interrupted provisioning to resume", rsp.Error.Message)
+
+	status, rsp = postProvision(t, handler, "/provision", &profile)
+	require.Equal(t, http.StatusConflict, status)
+	require.Equal(t, "Action cancelled by user", rsp.Error.Message)
+
+	var p Provisioning
+	err := json.Unmarshal(rsp.Data, &p)
+	require.NoError(t, err)
+	require.Equal(t, Provisioning{
+		Status:  provisionFailed,
+		Profile: &profile,
+		Steps: []ProvisionStep{
+			{Name: provisionStepWipe, Status: provisionDone, Message: "Device wiped"},
+			{Name: provisionStepGenerateMnemonic, Status: provisionDone, Message: "Mnemonic successfully configured"},
+			{Name: provisionStepBackup, Status: provisionFailed, Message: "Action cancelled by user"},
+		},
+	}, p)
+
+	// the provisioning resumes from the failed step, the device is not wiped again
+	status, rsp = postProvision(t, handler, "/provision/resume", nil)
+	require.Equal(t, http.StatusOK, status)
+	require.Nil(t, rsp.Error)
+
+	err = json.Unmarshal(rsp.Data, &p)
+	require.NoError(t, err)
+	require.Equal(t, Provisioning{
+		Status:  provisionDone,
+		Profile: &profile,
+		Steps: []ProvisionStep{
+			{Name: provisionStepWipe, Status: provisionDone, Message: "Device wiped"},
+			{Name: provisionStepGenerateMnemonic, Status: provisionDone, Message: "Mnemonic successfully configured"},
+			{Name: provisionStepBackup, Status: provisionDone, Message: "Device backed up!"},
+		},
+	}, p)
+
+	gateway.AssertExpectations(t)
+}
+
+func TestProvisionInterrupted(t *testing.T) {
+	profile := ProvisionProfile{
+		WordCount: 12,
+	}
+
+	gateway := &MockGatewayer{}
+	gateway.On("Wipe").Return(testSuccessMessage(t, "Device wiped"), nil)
+	gateway.On("GenerateMnemonic", uint32(12), false).Return(buttonRequestMessage, nil).Once()
+	gateway.On("GetFeatures").Return(testFeaturesMessage(t, "device-1", false), nil)
+	gateway.On("GenerateMnemonic", uint32(12), false).Return(testSuccessMessage(t, "Mnemonic successfully configured"), nil).Once()
+
+	handler := newServerMux(defaultMuxConfig(), gateway)
+
+	status, _ := postProvision(t, handler, "/provision", &profile)
+	require.Equal(t, http.StatusOK, status)
+
+	// starting another flow replaces the provisioning on the device
+	rr := serveTestRequest(t, handler, http.MethodGet, "/features", "", nil)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	require.Equal(t, Provisioning{
+		Status:  provisionInterrupted,
+		Profile: &profile,
+		Steps: []ProvisionStep{
+			{Name: provisionStepWipe, Status: provisionDone, Message: "Device wiped"},
+			{Name: provisionStepGenerateMnemonic, Status: provisionInterrupted},
+		},
+	}, getProvisioning(t, handler))
+
+	// a button request of another flow does not resume the provisioning
+	gateway.On("ButtonAck").Return(testSuccessMessage(t, "done"), nil).Once()
+	rr = serveTestRequest(t, handler, http.MethodPost, "/intermediate/button", "", nil)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	require.JSONEq(t, `{"data":["done"]}`, rr.Body.String())
+
+	status, _ = postProvision(t, handler, "/provision/resume", nil)
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, provisionDone, getProvisioning(t, handler).Status)
+
+	gateway.AssertNumberOfCalls(t, "Wipe", 1)
+	gateway.AssertExpectations(t)
+}
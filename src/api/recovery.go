@@ -19,7 +19,7 @@ type RecoveryRequest struct {
 // URI: /api/v1/recovery
 // Method: POST
 // Args: JSON Body
-func recovery(gateway Gatewayer) http.HandlerFunc {
+func recovery(gateway Gatewayer, addressBook *AddressBook) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
@@ -53,6 +53,12 @@ func recovery(gateway Gatewayer) http.HandlerFunc {
 			}
 		}
 
+		// a dry run only checks the backup, the seed does not change
+		var addressBookDone func(Gatewayer, wire.Message)
+		if !req.DryRun {
+			addressBookDone = addressBook.reset(gateway)
+		}
+
 		var msg wire.Message
 		var err error
 		retCH := make(chan int)
@@ -70,6 +76,7 @@ func recovery(gateway Gatewayer) http.HandlerFunc {
 
 		select {
 		case <-retCH:
+			addressBook.finish(gateway, addressBookDone, msg)
 			HandleFirmwareResponseMessages(w, msg)
 		case <-errCH:
 			logger.Errorf("recovery failed: %s", err.Error())
@@ -64,7 +64,7 @@ func TestRecovery(t *testing.T) {
 			name:         "409 - Failure msg",
 			method:       http.MethodPost,
 			status:       http.StatusConflict,
-			httpResponse: NewHTTPErrorResponse(http.StatusConflict, "failure msg"),
+			httpResponse: newTypedHTTPErrorResponse(http.StatusConflict, ErrorTypeNotInitialized, "failure msg"),
 			httpBody: toJSON(t, &RecoveryRequest{
 				WordCount: 2,
 			}),
@@ -0,0 +1,358 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// JSON-RPC 2.0 error codes
+const (
+	RPCCodeParseError     = -32700
+	RPCCodeInvalidRequest = -32600
+	RPCCodeMethodNotFound = -32601
+	RPCCodeInvalidParams  = -32602
+	// RPCCodeServerError is the code of the errors of the /api/v1 handlers, the HTTP error is the data of the error
+	RPCCodeServerError = -32000
+)
+
+const rpcVersion = "2.0"
+
+// RPCRequest is a JSON-RPC 2.0 request. A request without id is a notification, which has no response.
+type RPCRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+}
+
+// RPCResponse is a JSON-RPC 2.0 response
+type RPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Result  *RPCResult      `json:"result,omitempty"`
+	Error   *RPCError       `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// RPCResult is the result of a method, it has the data and the intermediate of the /api/v1 response
+type RPCResult struct {
+	Data         json.RawMessage       `json:"data,omitempty"`
+	Intermediate *IntermediateResponse `json:"intermediate,omitempty"`
+}
+
+// RPCError is the error of a JSON-RPC 2.0 response
+type RPCError struct {
+	Code    int        `json:"code"`
+	Message string     `json:"message"`
+	Data    *HTTPError `json:"data,omitempty"`
+}
+
+// rpcMethod maps a JSON-RPC method to the /api/v1 endpoint which serves it
+type rpcMethod struct {
+	name       string
+	v1Method   string
+	v1Endpoint string
+}
+
+// rpcMethods are the Gatewayer operations served by /api/v1, the params of a method are the JSON body of its endpoint
+var rpcMethods = []rpcMethod{
+	{"device.features", http.MethodGet, "/features"},
+	{"device.available", http.MethodGet, "/available"},
+	{"device.addressGen", http.MethodPost, "/generate_addresses"},
+	{"device.applySettings", http.MethodPost, "/apply_settings"},
+	{"device.backup", http.MethodPost, "/backup"},
+	{"device.cancel", http.MethodPut, "/cancel"},
+	{"device.checkMessageSignature", http.MethodPost, "/check_message_signature"},
+	{"device.checkArmoredMessageSignature", http.MethodPost, "/check_message_signature/armored"},
+	{"device.changePin", http.MethodPost, "/configure_pin_code"},
+	{"device.generateMnemonic", http.MethodPost, "/generate_mnemonic"},
+	{"device.resetDevice", http.MethodPost, "/generate_mnemonic"},
+	{"device.recovery", http.MethodPost, "/recovery"},
+	{"device.setMnemonic", http.MethodPost, "/set_mnemonic"},
+	{"device.signMessage", http.MethodPost, "/sign_message"},
+	{"device.signMessages", http.MethodPost, "/sign_messages"},
+	{"device.transactionSign", http.MethodPost, "/transaction_sign"},
+	{"device.wipe", http.MethodDelete, "/wipe"},
+	{"device.loadDevice", http.MethodPost, "/emulator/load_device"},
+	{"device.pinMatrixAck", http.MethodPost, "/intermediate/pin_matrix"},
+	{"device.passphraseAck", http.MethodPost, "/intermediate/passphrase"},
+	{"device.wordAck", http.MethodPost, "/intermediate/word"},
+	{"device.buttonAck", http.MethodPost, "/intermediate/button"},
+	{"device.passphraseStateAck", http.MethodPost, "/intermediate/passphrase_state"},
+	{"device.backupVerify", http.MethodPost, "/backup/verify"},
+	{"device.backupVerification", http.MethodGet, "/backup/verify"},
+	{"device.provision", http.MethodPost, "/provision"},
+	{"device.provisioning", http.MethodGet, "/provision"},
+	{"device.provisionResume", http.MethodPost, "/provision/resume"},
+	{"device.addressBook", http.MethodGet, "/addresses"},
+	{"device.accountDiscovery", http.MethodPost, "/account_discovery"},
+	{"device.verifyAddress", http.MethodPost, "/verify_address"},
+	{"device.addressIndex", http.MethodPost, "/address_index"},
+	{"device.entropyReport", http.MethodGet, "/entropy/report"},
+}
+
+// rpcExcludedEndpoints are the /api/v1 endpoints which are not served as JSON-RPC methods, with the reason
+var rpcExcludedEndpoints = map[string]string{
+	"/firmware_update":   "the firmware is uploaded as a multipart form, not a JSON body",
+	"/entropy/raw":       "the entropy is streamed as binary data, not JSON",
+	"/entropy/mixed":     "the entropy is streamed as binary data, not JSON",
+	"/addresses/":        "the QR code of an address is a PNG image, not JSON",
+	"/mnemonic/validate": "the mnemonic is checked by the daemon, without the device",
+	"/mnemonic/words":    "the word list is served by the daemon, without the device",
+	"/audit":             "the audit log is kept by the daemon, without the device",
+	"/version":           "the version is the daemon's, not the device's",
+	"/openapi.json":      "the spec describes the REST API",
+	"/rpc":               "it is the JSON-RPC endpoint",
+}
+
+func findRPCMethod(name string) (rpcMethod, bool) {
+	for _, m := range rpcMethods {
+		if m.name == name {
+			return m, true
+		}
+	}
+
+	return rpcMethod{}, false
+}
+
+// rpcMethodOfOperation returns the method of the operation of an intermediate response, the first one if several methods share its endpoint
+func rpcMethodOfOperation(operation string) string {
+	for _, m := range rpcMethods {
+		if strings.Trim(m.v1Endpoint, "/") == operation {
+			return m.name
+		}
+	}
+
+	return ""
+}
+
+// URI: /api/v1/rpc
+// Method: POST
+// Args: JSON-RPC 2.0 request or batch of requests
+func rpcHandler(v1 *http.ServeMux) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if r.Header.Get("Content-Type") != ContentTypeJSON {
+			resp := NewHTTPErrorResponse(http.StatusUnsupportedMediaType, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			resp := NewHTTPErrorResponse(http.StatusBadRequest, err.Error())
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		body = bytes.TrimSpace(body)
+		if len(body) == 0 || body[0] != '[' {
+			resp := serveRPCRequest(v1, r, body)
+			if resp == nil {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			writeRPCResponse(w, resp)
+			return
+		}
+
+		var batch []json.RawMessage
+		if err := json.Unmarshal(body, &batch); err != nil {
+			writeRPCResponse(w, newRPCErrorResponse(nil, RPCCodeParseError, err.Error()))
+			return
+		}
+
+		if len(batch) == 0 {
+			writeRPCResponse(w, newRPCErrorResponse(nil, RPCCodeInvalidRequest, "batch is empty"))
+			return
+		}
+
+		// the requests of a batch are sent to the device one after the other, in order
+		resps := make([]*RPCResponse, 0, len(batch))
+		for _, data := range batch {
+			if r.Context().Err() != nil {
+				return
+			}
+
+			if resp := serveRPCRequest(v1, r, data); resp != nil {
+				resps = append(resps, resp)
+			}
+		}
+
+		if len(resps) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		writeRPCResponse(w, resps)
+	}
+}
+
+// serveRPCRequest serves a request with its /api/v1 endpoint, it returns nil for a notification
+func serveRPCRequest(v1 *http.ServeMux, r *http.Request, data []byte) *RPCResponse {
+	var req RPCRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		if !json.Valid(data) {
+			return newRPCErrorResponse(nil, RPCCodeParseError, err.Error())
+		}
+		return newRPCErrorResponse(nil, RPCCodeInvalidRequest, err.Error())
+	}
+
+	if req.JSONRPC != rpcVersion {
+		return newRPCErrorResponse(req.ID, RPCCodeInvalidRequest, `jsonrpc must be "2.0"`)
+	}
+
+	if req.Method == "" {
+		return newRPCErrorResponse(req.ID, RPCCodeInvalidRequest, "method is required")
+	}
+
+	resp := callRPCMethod(v1, r, req)
+	if req.ID == nil {
+		return nil
+	}
+
+	return resp
+}
+
+func callRPCMethod(v1 *http.ServeMux, r *http.Request, req RPCRequest) *RPCResponse {
+	m, ok := findRPCMethod(req.Method)
+	if !ok {
+		return newRPCErrorResponse(req.ID, RPCCodeMethodNotFound, "method not found")
+	}
+
+	params := bytes.TrimSpace(req.Params)
+	if string(params) == "null" {
+		params = nil
+	}
+	if len(params) != 0 && params[0] != '{' {
+		return newRPCErrorResponse(req.ID, RPCCodeInvalidParams, "params must be an object")
+	}
+
+	v1r := newV1Request(r, m.v1Method, m.v1Endpoint)
+	v1r.Header.Set("Content-Type", ContentTypeJSON)
+	if m.v1Method == http.MethodGet {
+		// the args of GET endpoints are in the query
+		query, err := rpcQuery(params)
+		if err != nil {
+			return newRPCErrorResponse(req.ID, RPCCodeInvalidParams, err.Error())
+		}
+		v1r.URL.RawQuery = query.Encode()
+		v1r.RequestURI = v1r.URL.RequestURI()
+	} else {
+		v1r.Body = ioutil.NopCloser(bytes.NewReader(params))
+		v1r.ContentLength = int64(len(params))
+	}
+
+	// the endpoints of some methods are only registered for a device type
+	handler, pattern := v1.Handler(v1r)
+	if pattern == "" {
+		return newRPCErrorResponse(req.ID, RPCCodeMethodNotFound, "method is not available for this device type")
+	}
+
+	rec := newBufferedResponseWriter()
+	handler.ServeHTTP(rec, v1r)
+
+	var v1Resp ReceivedHTTPResponse
+	if err := json.Unmarshal(rec.body.Bytes(), &v1Resp); err != nil {
+		resp := newRPCErrorResponse(req.ID, RPCCodeServerError, http.StatusText(rec.status))
+		resp.Error.Data = &HTTPError{
+			Code:    rec.status,
+			Message: strings.TrimSpace(rec.body.String()),
+		}
+		return resp
+	}
+
+	if v1Resp.Error != nil {
+		code := RPCCodeServerError
+		if v1Resp.Error.Type == ErrorTypeInvalidRequest {
+			code = RPCCodeInvalidParams
+		}
+
+		resp := newRPCErrorResponse(req.ID, code, v1Resp.Error.Message)
+		resp.Error.Data = v1Resp.Error
+		return resp
+	}
+
+	if v1Resp.Intermediate != nil {
+		if name := rpcMethodOfOperation(v1Resp.Intermediate.Operation); name != "" {
+			v1Resp.Intermediate.Operation = name
+		}
+	}
+
+	return &RPCResponse{
+		JSONRPC: rpcVersion,
+		Result: &RPCResult{
+			Data:         v1Resp.Data,
+			Intermediate: v1Resp.Intermediate,
+		},
+		ID: req.ID,
+	}
+}
+
+// rpcQuery returns the query of the params of a GET method, which must be strings, numbers or booleans
+func rpcQuery(params json.RawMessage) (url.Values, error) {
+	query := url.Values{}
+	if len(params) == 0 {
+		return query, nil
+	}
+
+	var args map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader(params))
+	d.UseNumber()
+	if err := d.Decode(&args); err != nil {
+		return nil, err
+	}
+
+	for k, v := range args {
+		switch v := v.(type) {
+		case string:
+			query.Set(k, v)
+		case json.Number:
+			query.Set(k, v.String())
+		case bool:
+			query.Set(k, strconv.FormatBool(v))
+		default:
+			return nil, fmt.Errorf("param %s must be a string, a number or a boolean", k)
+		}
+	}
+
+	return query, nil
+}
+
+func newRPCErrorResponse(id json.RawMessage, code int, msg string) *RPCResponse {
+	return &RPCResponse{
+		JSONRPC: rpcVersion,
+		Error: &RPCError{
+			Code:    code,
+			Message: msg,
+		},
+		ID: id,
+	}
+}
+
+// writeRPCResponse writes a response or a batch of responses. JSON-RPC errors are sent with a 200 status.
+func writeRPCResponse(w http.ResponseWriter, resp interface{}) {
+	out, err := json.MarshalIndent(resp, "", "    ")
+	if err != nil {
+		writeHTTPResponse(w, NewHTTPErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	w.Header().Add("Content-Type", ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(out); err != nil {
+		logger.WithError(err).Error("http Write failed")
+	}
+}
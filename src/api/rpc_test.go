@@ -0,0 +1,316 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	skyWallet "github.com/SkycoinProject/hardware-wallet-go/src/skywallet"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+)
+
+func TestRPC(t *testing.T) {
+	type gatewayCall struct {
+		fn     string
+		args   []interface{}
+		result interface{}
+	}
+
+	cases := []struct {
+		name        string
+		method      string
+		contentType string
+		httpBody    string
+		calls       []gatewayCall
+		status      int
+		response    string
+	}{
+		{
+			name:        "405",
+			method:      http.MethodGet,
+			contentType: ContentTypeJSON,
+			status:      http.StatusMethodNotAllowed,
+			response:    `{"error":{"code":405,"message":"Method Not Allowed"}}`,
+		},
+
+		{
+			name:        "415",
+			method:      http.MethodPost,
+			contentType: "text/plain",
+			status:      http.StatusUnsupportedMediaType,
+			response:    `{"error":{"code":415,"message":"Unsupported Media Type"}}`,
+		},
+
+		{
+			name:        "parse error",
+			method:      http.MethodPost,
+			contentType: ContentTypeJSON,
+			httpBody:    `{"jsonrpc":"2.0",`,
+			status:      http.StatusOK,
+			response:    `{"jsonrpc":"2.0","error":{"code":-32700,"message":"unexpected end of JSON input"},"id":null}`,
+		},
+
+		{
+			name:        "invalid request - version",
+			method:      http.MethodPost,
+			contentType: ContentTypeJSON,
+			httpBody:    `{"jsonrpc":"1.0","method":"device.features","id":1}`,
+			status:      http.StatusOK,
+			response:    `{"jsonrpc":"2.0","error":{"code":-32600,"message":"jsonrpc must be \"2.0\""},"id":1}`,
+		},
+
+		{
+			name:        "invalid request - empty batch",
+			method:      http.MethodPost,
+			contentType: ContentTypeJSON,
+			httpBody:    `[]`,
+			status:      http.StatusOK,
+			response:    `{"jsonrpc":"2.0","error":{"code":-32600,"message":"batch is empty"},"id":null}`,
+		},
+
+		{
+			name:        "method not found",
+			method:      http.MethodPost,
+			contentType: ContentTypeJSON,
+			httpBody:    `{"jsonrpc":"2.0","method":"device.foo","id":1}`,
+			status:      http.StatusOK,
+			response:    `{"jsonrpc":"2.0","error":{"code":-32601,"message":"method not found"},"id":1}`,
+		},
+
+		{
+			name:        "invalid params - by position",
+			method:      http.MethodPost,
+			contentType: ContentTypeJSON,
+			httpBody:    `{"jsonrpc":"2.0","method":"device.addressGen","params":[1],"id":1}`,
+			status:      http.StatusOK,
+			response:    `{"jsonrpc":"2.0","error":{"code":-32602,"message":"params must be an object"},"id":1}`,
+		},
+
+		{
+			name:        "invalid params - spec",
+			method:      http.MethodPost,
+			contentType: ContentTypeJSON,
+			httpBody:    `{"jsonrpc":"2.0","method":"device.addressGen","params":{"address_n":"1"},"id":1}`,
+			status:      http.StatusOK,
+			response: `{"jsonrpc":"2.0","error":{"code":-32602,"message":"validation failure list:\naddress_n in body must be of type integer: \"string\"",
+				"data":{"code":422,"message":"validation failure list:\naddress_n in body must be of type integer: \"string\"","type":"invalid_request"}},"id":1}`,
+		},
+
+		{
+			name:        "get params",
+			method:      http.MethodPost,
+			contentType: ContentTypeJSON,
+			httpBody:    `{"jsonrpc":"2.0","method":"device.entropyReport","params":{"bytes":0,"type":"mixed"},"id":1}`,
+			status:      http.StatusOK,
+			response: `{"jsonrpc":"2.0","error":{"code":-32000,"message":"bytes cannot be 0",
+				"data":{"code":422,"message":"bytes cannot be 0"}},"id":1}`,
+		},
+
+		{
+			name:        "invalid params - get params",
+			method:      http.MethodPost,
+			contentType: ContentTypeJSON,
+			httpBody:    `{"jsonrpc":"2.0","method":"device.entropyReport","params":{"bytes":[1]},"id":1}`,
+			status:      http.StatusOK,
+			response:    `{"jsonrpc":"2.0","error":{"code":-32602,"message":"param bytes must be a string, a number or a boolean"},"id":1}`,
+		},
+
+		{
+			name:        "features",
+			method:      http.MethodPost,
+			contentType: ContentTypeJSON,
+			httpBody:    `{"jsonrpc":"2.0","method":"device.features","id":"a"}`,
+			calls: []gatewayCall{
+				{fn: "GetFeatures", result: testFeaturesMessage(t, testDeviceID, false)},
+			},
+			status:   http.StatusOK,
+			response: `{"jsonrpc":"2.0","result":{"data":{"device_id":"device-1","passphrase_protection":false}},"id":"a"}`,
+		},
+
+		{
+			name:        "address gen",
+			method:      http.MethodPost,
+			contentType: ContentTypeJSON,
+			httpBody:    `{"jsonrpc":"2.0","method":"device.addressGen","params":{"address_n":1},"id":1}`,
+			calls: []gatewayCall{
+				{fn: "AddressGen", args: []interface{}{uint32(1), uint32(0), false}, result: testAddressesMessage(t, "2EU3JbveHdkxW6z5tdhbbB2kRAWvXC2pLzw")},
+			},
+			status:   http.StatusOK,
+			response: `{"jsonrpc":"2.0","result":{"data":["2EU3JbveHdkxW6z5tdhbbB2kRAWvXC2pLzw"]},"id":1}`,
+		},
+
+		{
+			name:        "server error",
+			method:      http.MethodPost,
+			contentType: ContentTypeJSON,
+			httpBody:    `{"jsonrpc":"2.0","method":"device.wipe","id":1}`,
+			calls: []gatewayCall{
+				{fn: "Wipe", result: testFailureMessage(t, "Action cancelled by user")},
+			},
+			status: http.StatusOK,
+			response: `{"jsonrpc":"2.0","error":{"code":-32000,"message":"Action cancelled by user",
+				"data":{"code":409,"message":"Action cancelled by user","type":"action_cancelled"}},"id":1}`,
+		},
+
+		{
+			name:        "intermediate",
+			method:      http.MethodPost,
+			contentType: ContentTypeJSON,
+			httpBody:    `{"jsonrpc":"2.0","method":"device.wipe","id":1}`,
+			calls: []gatewayCall{
+				{fn: "Wipe", result: testButtonRequestMessage(t, messages.ButtonRequestType_ButtonRequest_WipeDevice)},
+			},
+			status: http.StatusOK,
+			response: `{"jsonrpc":"2.0","result":{"data":["ButtonRequest"],
+				"intermediate":{"kind":"button","operation":"device.wipe","button_request_type":"wipe_device"}},"id":1}`,
+		},
+
+		{
+			name:        "notification",
+			method:      http.MethodPost,
+			contentType: ContentTypeJSON,
+			httpBody:    `{"jsonrpc":"2.0","method":"device.wipe"}`,
+			calls: []gatewayCall{
+				{fn: "Wipe", result: testSuccessMessage(t, "Device wiped")},
+			},
+			status: http.StatusNoContent,
+		},
+
+		{
+			name:        "batch",
+			method:      http.MethodPost,
+			contentType: ContentTypeJSON,
+			httpBody: `[
+				{"jsonrpc":"2.0","method":"device.features","id":1},
+				{"jsonrpc":"2.0","method":"device.wipe"},
+				{"jsonrpc":"2.0","method":"device.foo","id":2},
+				1
+			]`,
+			calls: []gatewayCall{
+				{fn: "GetFeatures", result: testFeaturesMessage(t, testDeviceID, false)},
+				{fn: "Wipe", result: testSuccessMessage(t, "Device wiped")},
+			},
+			status: http.StatusOK,
+			response: `[
+				{"jsonrpc":"2.0","result":{"data":{"device_id":"device-1","passphrase_protection":false}},"id":1},
+				{"jsonrpc":"2.0","error":{"code":-32601,"message":"method not found"},"id":2},
+				{"jsonrpc":"2.0","error":{"code":-32600,"message":"json: cannot unmarshal number into Go value of type api.RPCRequest"},"id":null}
+			]`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+			for _, c := range tc.calls {
+				gateway.On(c.fn, c.args...).Return(c.result, nil)
+			}
+
+			handler := newServerMux(defaultMuxConfig(), gateway)
+
+			rr := serveTestRequest(t, handler, tc.method, "/rpc", tc.httpBody, map[string]string{
+				"Content-Type": tc.contentType,
+			})
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+			if tc.response == "" {
+				require.Empty(t, rr.Body.String())
+			} else {
+				require.JSONEq(t, tc.response, rr.Body.String())
+			}
+
+			gateway.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRPCDeviceType(t *testing.T) {
+	gateway := &MockGatewayer{}
+
+	cfg := defaultMuxConfig()
+	cfg.mode = skyWallet.DeviceTypeEmulator
+	handler := newServerMux(cfg, gateway)
+
+	// /available is only served for the usb device
+	rr := serveTestRequest(t, handler, http.MethodPost, "/rpc", `{"jsonrpc":"2.0","method":"device.available","id":1}`, map[string]string{
+		"Content-Type": ContentTypeJSON,
+	})
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	var rsp RPCResponse
+	err := json.NewDecoder(rr.Body).Decode(&rsp)
+	require.NoError(t, err)
+	require.Equal(t, &RPCError{
+		Code:    RPCCodeMethodNotFound,
+		Message: "method is not available for this device type",
+	}, rsp.Error)
+}
+
+func TestRPCCSRF(t *testing.T) {
+	gateway := &MockGatewayer{}
+
+	cfg := defaultMuxConfig()
+	cfg.enableCSRF = true
+	handler := newServerMux(cfg, gateway)
+
+	rr := serveTestRequest(t, handler, http.MethodPost, "/rpc", `{"jsonrpc":"2.0","method":"device.wipe","id":1}`, map[string]string{
+		"Content-Type": ContentTypeJSON,
+	})
+	require.Equal(t, http.StatusForbidden, rr.Code, rr.Body.String())
+
+	var rsp ReceivedHTTPResponse
+	err := json.NewDecoder(rr.Body).Decode(&rsp)
+	require.NoError(t, err)
+	require.Equal(t, &HTTPError{
+		Code:    http.StatusForbidden,
+		Message: ErrCSRFInvalid.Error(),
+	}, rsp.Error)
+}
+
+func TestRPCMethodsV1Routes(t *testing.T) {
+	cfg := defaultMuxConfig()
+	cfg.mode = skyWallet.DeviceTypeUSB
+	usbMux := newServerMux(cfg, &MockGatewayer{})
+	cfg.mode = skyWallet.DeviceTypeEmulator
+	emulatorMux := newServerMux(cfg, &MockGatewayer{})
+
+	// route returns the pattern of the /api/v1 handler which serves a request, without its prefix
+	route := func(mux *http.ServeMux, method, endpoint string) string {
+		req, err := http.NewRequest(method, "/api/v1"+endpoint, nil)
+		require.NoError(t, err)
+		_, pattern := mux.Handler(req)
+		return strings.TrimPrefix(pattern, "/api/v1")
+	}
+
+	methods := make(map[string]bool)
+	for _, m := range rpcMethods {
+		// the endpoint of every method is served, for the usb device or the emulator
+		r := route(usbMux, m.v1Method, m.v1Endpoint)
+		if r == "" {
+			r = route(emulatorMux, m.v1Method, m.v1Endpoint)
+		}
+		require.Equal(t, m.v1Endpoint, r, m.name)
+		methods[m.v1Method+" "+r] = true
+	}
+
+	// every v1 route is served by a method, or excluded
+	routes := map[string][]string{
+		"/api/v1/firmware_update": {http.MethodPut},
+	}
+	for e, verbs := range endpointsMethods {
+		routes[e] = verbs
+	}
+
+	for e, verbs := range routes {
+		for _, verb := range verbs {
+			r := route(usbMux, verb, strings.TrimPrefix(e, "/api/v1"))
+			require.NotEmpty(t, r, e)
+			if _, ok := rpcExcludedEndpoints[r]; ok {
+				continue
+			}
+			require.True(t, methods[verb+" "+r], "%s %s has no JSON-RPC method", verb, e)
+		}
+	}
+}
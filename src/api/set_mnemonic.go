@@ -3,11 +3,11 @@ package api
 import (
 	"encoding/json"
 	"net/http"
+	"strings"
 
 	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
 
 	skyWallet "github.com/SkycoinProject/hardware-wallet-go/src/skywallet"
-	"github.com/SkycoinProject/skycoin/src/cipher/bip39"
 )
 
 // SetMnemonicRequest is request data for /api/v1/set_mnemonic
@@ -18,7 +18,7 @@ type SetMnemonicRequest struct {
 // URI: /api/v1/set_mnemonic
 // Method: POST
 // Args: JSON Body
-func setMnemonic(gateway Gatewayer) http.HandlerFunc {
+func setMnemonic(gateway Gatewayer, auditLog *AuditLog, addressBook *AddressBook) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
@@ -40,8 +40,9 @@ func setMnemonic(gateway Gatewayer) http.HandlerFunc {
 		}
 		defer r.Body.Close()
 
-		if err := bip39.ValidateMnemonic(req.Mnemonic); err != nil {
-			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, "seed is not a valid bip39 seed")
+		if v := validateMnemonic(req.Mnemonic); !v.Valid {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, "seed is not a valid bip39 seed: "+v.Error)
+			resp.Data = v
 			writeHTTPResponse(w, resp)
 			return
 		}
@@ -57,6 +58,12 @@ func setMnemonic(gateway Gatewayer) http.HandlerFunc {
 			}
 		}
 
+		auditEntry := auditLog.begin(r, gateway, "set_mnemonic", auditSetMnemonic{
+			WordCount: len(strings.Fields(req.Mnemonic)),
+		})
+
+		addressBookDone := addressBook.reset(gateway)
+
 		var msg wire.Message
 		var err error
 		retCH := make(chan int)
@@ -74,12 +81,16 @@ func setMnemonic(gateway Gatewayer) http.HandlerFunc {
 
 		select {
 		case <-retCH:
+			addressBook.finish(gateway, addressBookDone, msg)
+			auditLog.finish(auditEntry, msg)
 			HandleFirmwareResponseMessages(w, msg)
 		case <-errCH:
 			logger.Errorf("setMnemonic failed: %s", err.Error())
+			auditLog.finishWithResult(auditEntry, auditResultError, err.Error())
 			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
 			writeHTTPResponse(w, resp)
 		case <-ctx.Done():
+			auditLog.finishWithResult(auditEntry, auditResultClosed, "")
 			disConnErr := gateway.Disconnect()
 			if disConnErr != nil {
 				resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
@@ -67,7 +67,39 @@ func TestSetMnemonic(t *testing.T) {
 			httpBody: toJSON(t, &SetMnemonicRequest{
 				Mnemonic: "foo bar foo bar",
 			}),
-			httpResponse: NewHTTPErrorResponse(http.StatusUnprocessableEntity, "seed is not a valid bip39 seed"),
+			httpResponse: HTTPResponse{
+				Error: &HTTPError{
+					Code:    http.StatusUnprocessableEntity,
+					Message: "seed is not a valid bip39 seed: mnemonic must have 12 or 24 words",
+				},
+				Data: MnemonicValidation{
+					WordCount: 4,
+					Error:     "mnemonic must have 12 or 24 words",
+					InvalidWords: []InvalidMnemonicWord{
+						{Index: 0, Word: "foo", Suggestions: []string{"food", "foot", "fog"}},
+						{Index: 2, Word: "foo", Suggestions: []string{"food", "foot", "fog"}},
+					},
+				},
+			},
+		},
+
+		{
+			name:   "422 - checksum incorrect",
+			method: http.MethodPost,
+			status: http.StatusUnprocessableEntity,
+			httpBody: toJSON(t, &SetMnemonicRequest{
+				Mnemonic: "cloud flower upset remain green metal below cup stem infant art art",
+			}),
+			httpResponse: HTTPResponse{
+				Error: &HTTPError{
+					Code:    http.StatusUnprocessableEntity,
+					Message: "seed is not a valid bip39 seed: mnemonic checksum is incorrect",
+				},
+				Data: MnemonicValidation{
+					WordCount: 12,
+					Error:     "mnemonic checksum is incorrect",
+				},
+			},
 		},
 
 		{
@@ -81,7 +113,7 @@ func TestSetMnemonic(t *testing.T) {
 			httpBody: toJSON(t, &SetMnemonicRequest{
 				Mnemonic: "cloud flower upset remain green metal below cup stem infant art thank",
 			}),
-			httpResponse: NewHTTPErrorResponse(http.StatusConflict, "failure msg"),
+			httpResponse: newTypedHTTPErrorResponse(http.StatusConflict, ErrorTypeNotInitialized, "failure msg"),
 		},
 
 		{
@@ -139,12 +171,7 @@ func TestSetMnemonic(t *testing.T) {
 				require.Nil(t, tc.httpResponse.Data)
 			} else {
 				require.NotNil(t, tc.httpResponse.Data)
-
-				var resp []string
-				err = json.Unmarshal(rsp.Data, &resp)
-				require.NoError(t, err)
-
-				require.Equal(t, tc.httpResponse.Data, resp)
+				require.JSONEq(t, toJSON(t, tc.httpResponse.Data), string(rsp.Data))
 			}
 		})
 	}
@@ -11,19 +11,27 @@ import (
 
 // SignMessageRequest is request data for /api/v1/sign_message
 type SignMessageRequest struct {
-	AddressN int    `json:"address_n"`
-	Message  string `json:"message"`
+	AddressN int `json:"address_n"`
+	// Address is resolved to its index on the device and can be used instead of AddressN
+	Address string `json:"address"`
+	Message string `json:"message"`
+	// Armored also returns the signed message in the armored text format
+	Armored bool `json:"armored"`
 }
 
 // SignMessageResponse is data returned by POST /api/v1/sign_message
 type SignMessageResponse struct {
 	Signature string `json:"signature"`
+	// Address is recovered from the signature and matches the address derived by the device
+	Address string `json:"address"`
+	// Armored is the signed message in the armored text format, if requested
+	Armored string `json:"armored,omitempty"`
 }
 
 // URI: /api/v1/signMessage
 // Method: POST
 // Args: JSON Body
-func signMessage(gateway Gatewayer) http.HandlerFunc {
+func signMessage(gateway Gatewayer, auditLog *AuditLog, lookup *addressLookup, signatures *signatureCheck) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
@@ -57,6 +65,20 @@ func signMessage(gateway Gatewayer) http.HandlerFunc {
 			return
 		}
 
+		if req.Address != "" {
+			if req.AddressN != 0 {
+				resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, "address_n and address cannot be used together")
+				writeHTTPResponse(w, resp)
+				return
+			}
+
+			index, ok := lookup.resolve(w, r, req.Address)
+			if !ok {
+				return
+			}
+			req.AddressN = int(index)
+		}
+
 		// for integration tests
 		if autoPressEmulatorButtons {
 			err := gateway.SetAutoPressButton(true, skyWallet.ButtonRight)
@@ -68,6 +90,13 @@ func signMessage(gateway Gatewayer) http.HandlerFunc {
 			}
 		}
 
+		auditEntry := auditLog.begin(r, gateway, "sign_message", auditSignMessage{
+			AddressN: req.AddressN,
+			Message:  req.Message,
+		})
+
+		signatures.begin(req.AddressN, req.Message, req.Armored)
+
 		var msg wire.Message
 		var err error
 		retCH := make(chan int)
@@ -85,12 +114,15 @@ func signMessage(gateway Gatewayer) http.HandlerFunc {
 
 		select {
 		case <-retCH:
-			HandleFirmwareResponseMessages(w, msg)
+			auditLog.finish(auditEntry, msg)
+			signatures.respond(w, gateway, msg)
 		case <-errCH:
 			logger.Errorf("signMessage failed: %s", err.Error())
+			auditLog.finishWithResult(auditEntry, auditResultError, err.Error())
 			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
 			writeHTTPResponse(w, resp)
 		case <-ctx.Done():
+			auditLog.finishWithResult(auditEntry, auditResultClosed, "")
 			disConnErr := gateway.Disconnect()
 			if disConnErr != nil {
 				resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
@@ -12,6 +12,12 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+const (
+	testMessage          = "Hello World"
+	testMessageAddress   = "2EU3JbveHdkxW6z5tdhbbB2kRAWvXC2pLzw"
+	testMessageSignature = "6ebd63dd5e57cad07b6d229e96b5d2ac7d1bec1466d2a95bd200c21be6a0bf194b5ad5123f6e37c6393ee3635b38b938fcd91bbf1327fc957849a9e5736f6e4300"
+)
+
 func TestSignMessage(t *testing.T) {
 	failureMsg := messages.Failure{
 		Code:    messages.FailureType_Failure_NotInitialized.Enum(),
@@ -28,6 +34,7 @@ func TestSignMessage(t *testing.T) {
 		contentType              string
 		httpBody                 string
 		gatewaySignMessageResult wire.Message
+		gatewayAddressGenResult  wire.Message
 		httpResponse             HTTPResponse
 	}{
 		{
@@ -95,7 +102,79 @@ func TestSignMessage(t *testing.T) {
 				Kind: uint16(messages.MessageType_MessageType_Failure),
 				Data: failureMsgBytes,
 			},
-			httpResponse: NewHTTPErrorResponse(http.StatusConflict, "failure msg"),
+			httpResponse: newTypedHTTPErrorResponse(http.StatusConflict, ErrorTypeNotInitialized, "failure msg"),
+		},
+
+		{
+			name:        "200 - OK",
+			method:      http.MethodPost,
+			status:      http.StatusOK,
+			contentType: ContentTypeJSON,
+			httpBody: toJSON(t, &SignMessageRequest{
+				AddressN: 0,
+				Message:  testMessage,
+			}),
+			gatewaySignMessageResult: testSignatureMessage(t, testMessageSignature),
+			gatewayAddressGenResult:  testAddressesMessage(t, testMessageAddress),
+			httpResponse: HTTPResponse{
+				Data: SignMessageResponse{
+					Signature: testMessageSignature,
+					Address:   testMessageAddress,
+				},
+			},
+		},
+
+		{
+			name:        "200 - armored",
+			method:      http.MethodPost,
+			status:      http.StatusOK,
+			contentType: ContentTypeJSON,
+			httpBody: toJSON(t, &SignMessageRequest{
+				AddressN: 0,
+				Message:  testMessage,
+				Armored:  true,
+			}),
+			gatewaySignMessageResult: testSignatureMessage(t, testMessageSignature),
+			gatewayAddressGenResult:  testAddressesMessage(t, testMessageAddress),
+			httpResponse: HTTPResponse{
+				Data: SignMessageResponse{
+					Signature: testMessageSignature,
+					Address:   testMessageAddress,
+					Armored: SignedMessage{
+						Message:   testMessage,
+						Address:   testMessageAddress,
+						Signature: testMessageSignature,
+					}.Armor(),
+				},
+			},
+		},
+
+		{
+			name:        "500 - signature does not match the device address",
+			method:      http.MethodPost,
+			status:      http.StatusInternalServerError,
+			contentType: ContentTypeJSON,
+			httpBody: toJSON(t, &SignMessageRequest{
+				AddressN: 0,
+				Message:  testMessage,
+			}),
+			gatewaySignMessageResult: testSignatureMessage(t, testMessageSignature),
+			gatewayAddressGenResult:  testAddressesMessage(t, "u37EnnuQ4g58sWpd5Ns3FWGPwSgEuQGFBd"),
+			httpResponse:             NewHTTPErrorResponse(http.StatusInternalServerError, "signature self-check failed: signature does not match the device address at index 0"),
+		},
+
+		{
+			name:        "500 - signature of another message",
+			method:      http.MethodPost,
+			status:      http.StatusInternalServerError,
+			contentType: ContentTypeJSON,
+			httpBody: toJSON(t, &SignMessageRequest{
+				AddressN: 0,
+				Message:  "foo",
+			}),
+			gatewaySignMessageResult: testSignatureMessage(t, testMessageSignature),
+			gatewayAddressGenResult:  testAddressesMessage(t, testMessageAddress),
+			httpResponse:             NewHTTPErrorResponse(http.StatusInternalServerError, "signature self-check failed: signature does not match the device address at index 0"),
 		},
 	}
 
@@ -108,6 +187,7 @@ func TestSignMessage(t *testing.T) {
 			err := json.Unmarshal([]byte(tc.httpBody), &body)
 			if err == nil {
 				gateway.On("SignMessage", body.AddressN, body.Message).Return(tc.gatewaySignMessageResult, nil)
+				gateway.On("AddressGen", uint32(1), uint32(body.AddressN), false).Return(tc.gatewayAddressGenResult, nil)
 			}
 
 			req, err := http.NewRequest(tc.method, "/api/v1"+endpoint, strings.NewReader(tc.httpBody))
@@ -127,7 +207,7 @@ func TestSignMessage(t *testing.T) {
 			status := rr.Code
 			require.Equal(t, tc.status, status, "got `%v` want `%v`", status, tc.status)
 
-			var rsp HTTPResponse
+			var rsp ReceivedHTTPResponse
 			err = json.NewDecoder(rr.Body).Decode(&rsp)
 			require.NoError(t, err)
 
@@ -135,7 +215,43 @@ func TestSignMessage(t *testing.T) {
 
 			if rsp.Data == nil {
 				require.Nil(t, tc.httpResponse.Data)
+			} else {
+				require.JSONEq(t, toJSON(t, tc.httpResponse.Data), string(rsp.Data))
 			}
 		})
 	}
 }
+
+func TestSignMessageButton(t *testing.T) {
+	gateway := &MockGatewayer{}
+	gateway.On("SignMessage", 3, testMessage).Return(buttonRequestMessage, nil)
+	gateway.On("ButtonAck").Return(testSignatureMessage(t, testMessageSignature), nil)
+	gateway.On("AddressGen", uint32(1), uint32(3), false).Return(testAddressesMessage(t, testMessageAddress), nil)
+
+	handler := newServerMux(defaultMuxConfig(), gateway)
+
+	rr := serveTestRequest(t, handler, http.MethodPost, "/sign_message", toJSON(t, SignMessageRequest{
+		AddressN: 3,
+		Message:  testMessage,
+	}), map[string]string{
+		"Content-Type": ContentTypeJSON,
+	})
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	require.JSONEq(t, `{"data":["ButtonRequest"],"intermediate":{"kind":"button","operation":"sign_message"}}`, rr.Body.String())
+
+	// the signature is checked once the user confirms the message
+	rr = serveTestRequest(t, handler, http.MethodPost, "/intermediate/button", "", nil)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	var rsp ReceivedHTTPResponse
+	err := json.NewDecoder(rr.Body).Decode(&rsp)
+	require.NoError(t, err)
+
+	var result SignMessageResponse
+	err = json.Unmarshal(rsp.Data, &result)
+	require.NoError(t, err)
+	require.Equal(t, SignMessageResponse{
+		Signature: testMessageSignature,
+		Address:   testMessageAddress,
+	}, result)
+}
@@ -0,0 +1,327 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+
+	skyWallet "github.com/SkycoinProject/hardware-wallet-go/src/skywallet"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+)
+
+// maxSignMessages is the maximum number of messages signed in one request
+const maxSignMessages = 100
+
+// SignMessagesRequest is request data for /api/v1/sign_messages
+type SignMessagesRequest struct {
+	Messages []SignMessageRequest `json:"messages"`
+}
+
+// SignMessagesResponse is data returned by POST /api/v1/sign_messages
+type SignMessagesResponse struct {
+	// Signatures are in the order of the messages. If a message fails,
+	// only the signatures of the messages before it are returned.
+	Signatures []string `json:"signatures"`
+	// Failed is the position of the message which failed or was rejected by the user
+	Failed *int `json:"failed,omitempty"`
+}
+
+// signMessages signs the messages one after the other, confirming each one on the device.
+// If the device asks for a pin code or passphrase before the first signature,
+// the firmware message is returned. If a message fails, the signatures before it,
+// its position and the firmware message are returned.
+// The firmware response to the first message is passed in signed when the signing
+// is resumed after the device asked for user input.
+func signMessages(gateway Gatewayer, reqs []SignMessageRequest, signed *wire.Message) ([]string, *int, wire.Message, error) {
+	signatures := make([]string, 0, len(reqs))
+
+	for i, req := range reqs {
+		var msg wire.Message
+		var err error
+		if i == 0 && signed != nil {
+			msg = *signed
+		} else {
+			msg, err = gateway.SignMessage(req.AddressN, req.Message)
+			if err != nil {
+				return nil, nil, wire.Message{}, err
+			}
+		}
+
+		// the message is shown on the device until the user confirms or rejects it
+		for msg.Kind == uint16(messages.MessageType_MessageType_ButtonRequest) {
+			msg, err = gateway.ButtonAck()
+			if err != nil {
+				return nil, nil, wire.Message{}, err
+			}
+		}
+
+		switch msg.Kind {
+		case uint16(messages.MessageType_MessageType_ResponseSkycoinSignMessage):
+			signature, err := skyWallet.DecodeResponseSkycoinSignMessage(msg)
+			if err != nil {
+				return nil, nil, wire.Message{}, err
+			}
+			signatures = append(signatures, signature)
+		case uint16(messages.MessageType_MessageType_Failure):
+			failed := i
+			return signatures, &failed, msg, nil
+		default:
+			if i == 0 {
+				return nil, nil, msg, nil
+			}
+			return nil, nil, wire.Message{}, fmt.Errorf("message %d: received unexpected response message type: %s", i, messages.MessageType(msg.Kind))
+		}
+	}
+
+	return signatures, nil, wire.Message{}, nil
+}
+
+// URI: /api/v1/sign_messages
+// Method: POST
+// Args: JSON Body
+func signMessagesHandler(gateway Gatewayer, auditLog *AuditLog, lookup *addressLookup, signings *messageSignings) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if r.Header.Get("Content-Type") != ContentTypeJSON {
+			resp := NewHTTPErrorResponse(http.StatusUnsupportedMediaType, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		var req SignMessagesRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			resp := NewHTTPErrorResponse(http.StatusBadRequest, err.Error())
+			writeHTTPResponse(w, resp)
+			return
+		}
+		defer r.Body.Close()
+
+		if len(req.Messages) == 0 {
+			resp := NewHTTPErrorResponse(http.StatusBadRequest, "messages are required")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if len(req.Messages) > maxSignMessages {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, fmt.Sprintf("at most %d messages can be signed at once", maxSignMessages))
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		for i, m := range req.Messages {
+			if m.AddressN < 0 {
+				resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, fmt.Sprintf("message %d: address_n cannot be negative", i))
+				writeHTTPResponse(w, resp)
+				return
+			}
+
+			if m.Message == "" {
+				resp := NewHTTPErrorResponse(http.StatusBadRequest, fmt.Sprintf("message %d: message is required", i))
+				writeHTTPResponse(w, resp)
+				return
+			}
+
+			if m.Armored {
+				resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, fmt.Sprintf("message %d: armored is not supported when signing several messages", i))
+				writeHTTPResponse(w, resp)
+				return
+			}
+
+			if m.Address != "" && m.AddressN != 0 {
+				resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, fmt.Sprintf("message %d: address_n and address cannot be used together", i))
+				writeHTTPResponse(w, resp)
+				return
+			}
+		}
+
+		for i, m := range req.Messages {
+			if m.Address == "" {
+				continue
+			}
+
+			index, ok := lookup.resolve(w, r, m.Address)
+			if !ok {
+				return
+			}
+			req.Messages[i].AddressN = int(index)
+		}
+
+		// for integration tests
+		if autoPressEmulatorButtons {
+			err := gateway.SetAutoPressButton(true, skyWallet.ButtonRight)
+			if err != nil {
+				logger.Error("signMessages failed: %s", err.Error())
+				resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+				writeHTTPResponse(w, resp)
+				return
+			}
+		}
+
+		summary := make([]auditSignMessage, len(req.Messages))
+		for i, m := range req.Messages {
+			summary[i] = auditSignMessage{
+				AddressN: m.AddressN,
+				Message:  m.Message,
+			}
+		}
+		auditEntry := auditLog.begin(r, gateway, "sign_messages", summary)
+
+		signings.respond(w, r, gateway, pendingSigning{
+			messages:   req.Messages,
+			auditEntry: auditEntry,
+		}, nil)
+	}
+}
+
+// pendingSigning is a batch of messages waiting for a pin code or passphrase before the first signature
+type pendingSigning struct {
+	messages   []SignMessageRequest
+	auditEntry *AuditEntry
+}
+
+// messageSignings keeps a batch of messages pending while the device asks for a pin code
+// or passphrase, so that the messages after the first one are signed after the intermediate request.
+// The batch is only recorded in the audit log once every message is signed or one of them failed.
+type messageSignings struct {
+	auditLog *AuditLog
+	lock     sync.Mutex
+	pending  *pendingSigning
+}
+
+func newMessageSignings(auditLog *AuditLog) *messageSignings {
+	return &messageSignings{
+		auditLog: auditLog,
+	}
+}
+
+// take stops tracking the pending batch and returns it
+func (s *messageSignings) take() *pendingSigning {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	pending := s.pending
+	s.pending = nil
+	return pending
+}
+
+// interrupt records the pending batch as interrupted, it was replaced on the device by a new flow
+func (s *messageSignings) interrupt() {
+	if pending := s.take(); pending != nil {
+		s.auditLog.finishWithResult(pending.auditEntry, auditResultInterrupted, "")
+	}
+}
+
+// failFlow records the pending batch as failed
+func (s *messageSignings) failFlow(err error) {
+	if pending := s.take(); pending != nil {
+		s.auditLog.finishWithResult(pending.auditEntry, auditResultError, err.Error())
+	}
+}
+
+// cancelFlow records the answer of the device to a cancel request against the pending batch
+func (s *messageSignings) cancelFlow(gateway Gatewayer, msg wire.Message) {
+	if pending := s.take(); pending != nil {
+		s.auditLog.finish(pending.auditEntry, msg)
+	}
+}
+
+// resumeFlow signs the remaining messages of the pending batch, the firmware response
+// of the intermediate request is the answer to the first message
+func (s *messageSignings) resumeFlow(w http.ResponseWriter, r *http.Request, gateway Gatewayer, msg wire.Message) bool {
+	if isIntermediateMessage(msg) {
+		return false
+	}
+
+	pending := s.take()
+	if pending == nil {
+		return false
+	}
+
+	s.respond(w, r, gateway, *pending, &msg)
+	return true
+}
+
+// respond signs the messages and writes the signatures.
+// If the device asks for a pin code or passphrase the batch is kept pending.
+func (s *messageSignings) respond(w http.ResponseWriter, r *http.Request, gateway Gatewayer, signing pendingSigning, signed *wire.Message) {
+	auditLog := s.auditLog
+	auditEntry := signing.auditEntry
+
+	var signatures []string
+	var failed *int
+	var msg wire.Message
+	var err error
+	retCH := make(chan int, 1)
+	errCH := make(chan int, 1)
+	ctx := r.Context()
+
+	go func() {
+		signatures, failed, msg, err = signMessages(gateway, signing.messages, signed)
+		if err != nil {
+			errCH <- 1
+			return
+		}
+		retCH <- 1
+	}()
+
+	select {
+	case <-retCH:
+		switch {
+		case failed != nil:
+			result, failureMsg := auditResult(msg)
+			auditLog.finishWithResult(auditEntry, result, fmt.Sprintf("message %d: %s", *failed, failureMsg))
+
+			var resp HTTPResponse
+			if failure, err := decodeFailure(msg); err != nil {
+				resp = NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+			} else {
+				resp = failureResponse(failure, fmt.Sprintf("message %d: %s", *failed, failureMsg))
+			}
+			resp.Data = SignMessagesResponse{
+				Signatures: signatures,
+				Failed:     failed,
+			}
+			writeHTTPResponse(w, resp)
+		case signatures == nil:
+			// the device asked for a pin code or passphrase, or failed
+			if isIntermediateMessage(msg) {
+				s.lock.Lock()
+				s.pending = &signing
+				s.lock.Unlock()
+			} else {
+				auditLog.finish(auditEntry, msg)
+			}
+			HandleFirmwareResponseMessages(w, msg)
+		default:
+			auditLog.finishWithResult(auditEntry, auditResultSuccess, "")
+			writeHTTPResponse(w, HTTPResponse{
+				Data: SignMessagesResponse{
+					Signatures: signatures,
+				},
+			})
+		}
+	case <-errCH:
+		logger.Errorf("signMessages failed: %s", err.Error())
+		auditLog.finishWithResult(auditEntry, auditResultError, err.Error())
+		resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+		writeHTTPResponse(w, resp)
+	case <-ctx.Done():
+		auditLog.finishWithResult(auditEntry, auditResultClosed, "")
+		disConnErr := gateway.Disconnect()
+		if disConnErr != nil {
+			resp := NewHTTPErrorResponse(http.StatusInternalServerError, disConnErr.Error())
+			writeHTTPResponse(w, resp)
+		} else {
+			resp := NewHTTPErrorResponse(499, "Client Closed Request")
+			writeHTTPResponse(w, resp)
+		}
+	}
+}
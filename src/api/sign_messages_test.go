@@ -0,0 +1,330 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+	"github.com/stretchr/testify/require"
+)
+
+func testSignatureMessage(t *testing.T, signature string) wire.Message {
+	rsp := messages.ResponseSkycoinSignMessage{
+		SignedMessage: newStrPtr(signature),
+	}
+
+	data, err := rsp.Marshal()
+	require.NoError(t, err)
+
+	return wire.Message{
+		Kind: uint16(messages.MessageType_MessageType_ResponseSkycoinSignMessage),
+		Data: data,
+	}
+}
+
+func TestSignMessages(t *testing.T) {
+	pinMatrixRequestMessage := wire.Message{
+		Kind: uint16(messages.MessageType_MessageType_PinMatrixRequest),
+	}
+
+	twoMessages := SignMessagesRequest{
+		Messages: []SignMessageRequest{
+			{AddressN: 0, Message: "foo"},
+			{AddressN: 2, Message: "bar"},
+		},
+	}
+
+	tooManyMessages := SignMessagesRequest{
+		Messages: make([]SignMessageRequest, maxSignMessages+1),
+	}
+	for i := range tooManyMessages.Messages {
+		tooManyMessages.Messages[i].Message = "foo"
+	}
+
+	failed := 1
+
+	cases := []struct {
+		name         string
+		method       string
+		status       int
+		contentType  string
+		httpBody     string
+		fooResult    []wire.Message
+		barResult    []wire.Message
+		httpResponse HTTPResponse
+		result       *SignMessagesResponse
+	}{
+		{
+			name:         "405",
+			method:       http.MethodGet,
+			status:       http.StatusMethodNotAllowed,
+			httpResponse: NewHTTPErrorResponse(http.StatusMethodNotAllowed, ""),
+		},
+
+		{
+			name:         "415 - Unsupported Media Type",
+			method:       http.MethodPost,
+			contentType:  ContentTypeForm,
+			status:       http.StatusUnsupportedMediaType,
+			httpResponse: NewHTTPErrorResponse(http.StatusUnsupportedMediaType, ""),
+		},
+
+		{
+			name:         "422 - no messages",
+			method:       http.MethodPost,
+			contentType:  ContentTypeJSON,
+			status:       http.StatusUnprocessableEntity,
+			httpBody:     toJSON(t, SignMessagesRequest{}),
+			httpResponse: newInvalidRequestResponse("messages in body must be of type array: \"null\""),
+		},
+
+		{
+			name:         "400 - empty message",
+			method:       http.MethodPost,
+			contentType:  ContentTypeJSON,
+			status:       http.StatusBadRequest,
+			httpBody:     toJSON(t, SignMessagesRequest{Messages: []SignMessageRequest{{Message: "foo"}, {AddressN: 1}}}),
+			httpResponse: NewHTTPErrorResponse(http.StatusBadRequest, "message 1: message is required"),
+		},
+
+		{
+			name:         "422 - negative address_n",
+			method:       http.MethodPost,
+			contentType:  ContentTypeJSON,
+			status:       http.StatusUnprocessableEntity,
+			httpBody:     toJSON(t, SignMessagesRequest{Messages: []SignMessageRequest{{AddressN: -1, Message: "foo"}}}),
+			httpResponse: NewHTTPErrorResponse(http.StatusUnprocessableEntity, "message 0: address_n cannot be negative"),
+		},
+
+		{
+			name:         "422 - too many messages",
+			method:       http.MethodPost,
+			contentType:  ContentTypeJSON,
+			status:       http.StatusUnprocessableEntity,
+			httpBody:     toJSON(t, tooManyMessages),
+			httpResponse: newInvalidRequestResponse("messages in body should have at most 100 items"),
+		},
+
+		{
+			name:        "200 - PinMatrixRequest",
+			method:      http.MethodPost,
+			contentType: ContentTypeJSON,
+			status:      http.StatusOK,
+			httpBody:    toJSON(t, twoMessages),
+			fooResult:   []wire.Message{pinMatrixRequestMessage},
+			httpResponse: HTTPResponse{
+				Data: []string{"PinMatrixRequest"},
+			},
+		},
+
+		{
+			name:        "200 - OK",
+			method:      http.MethodPost,
+			contentType: ContentTypeJSON,
+			status:      http.StatusOK,
+			httpBody:    toJSON(t, twoMessages),
+			fooResult:   []wire.Message{buttonRequestMessage, testSignatureMessage(t, "sig-foo")},
+			barResult:   []wire.Message{buttonRequestMessage, testSignatureMessage(t, "sig-bar")},
+			result: &SignMessagesResponse{
+				Signatures: []string{"sig-foo", "sig-bar"},
+			},
+		},
+
+		{
+			name:         "409 - second message rejected",
+			method:       http.MethodPost,
+			contentType:  ContentTypeJSON,
+			status:       http.StatusConflict,
+			httpBody:     toJSON(t, twoMessages),
+			fooResult:    []wire.Message{buttonRequestMessage, testSignatureMessage(t, "sig-foo")},
+			barResult:    []wire.Message{buttonRequestMessage, testFailureMessage(t, "Action cancelled by user")},
+			httpResponse: newTypedHTTPErrorResponse(http.StatusConflict, ErrorTypeActionCancelled, "message 1: Action cancelled by user"),
+			result: &SignMessagesResponse{
+				Signatures: []string{"sig-foo"},
+				Failed:     &failed,
+			},
+		},
+
+		{
+			name:         "500 - unexpected prompt",
+			method:       http.MethodPost,
+			contentType:  ContentTypeJSON,
+			status:       http.StatusInternalServerError,
+			httpBody:     toJSON(t, twoMessages),
+			fooResult:    []wire.Message{buttonRequestMessage, testSignatureMessage(t, "sig-foo")},
+			barResult:    []wire.Message{pinMatrixRequestMessage},
+			httpResponse: NewHTTPErrorResponse(http.StatusInternalServerError, "message 1: received unexpected response message type: MessageType_PinMatrixRequest"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+
+			// the gateway returns the first message of an item from SignMessage
+			// and the following ones from ButtonAck
+			var acks []wire.Message
+			if len(tc.fooResult) > 0 {
+				gateway.On("SignMessage", 0, "foo").Return(tc.fooResult[0], nil).Once()
+				acks = append(acks, tc.fooResult[1:]...)
+			}
+			if len(tc.barResult) > 0 {
+				gateway.On("SignMessage", 2, "bar").Return(tc.barResult[0], nil).Once()
+				acks = append(acks, tc.barResult[1:]...)
+			}
+			for _, ack := range acks {
+				gateway.On("ButtonAck").Return(ack, nil).Once()
+			}
+
+			handler := newServerMux(defaultMuxConfig(), gateway)
+
+			rr := serveTestRequest(t, handler, tc.method, "/sign_messages", tc.httpBody, map[string]string{
+				"Content-Type": tc.contentType,
+			})
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+
+			var rsp ReceivedHTTPResponse
+			err := json.NewDecoder(rr.Body).Decode(&rsp)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.httpResponse.Error, rsp.Error)
+
+			if tc.result != nil {
+				var result SignMessagesResponse
+				err = json.Unmarshal(rsp.Data, &result)
+				require.NoError(t, err)
+				require.Equal(t, *tc.result, result)
+			} else if tc.httpResponse.Data != nil {
+				require.JSONEq(t, toJSON(t, tc.httpResponse.Data), string(rsp.Data))
+			}
+
+			gateway.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSignMessagesAudit(t *testing.T) {
+	gateway := &MockGatewayer{}
+	gateway.On("GetFeatures").Return(testFeaturesMessage(t, "device-1", false), nil)
+	gateway.On("SignMessage", 0, "foo").Return(testSignatureMessage(t, "sig-foo"), nil)
+	gateway.On("SignMessage", 2, "bar").Return(testFailureMessage(t, "Action cancelled by user"), nil)
+
+	auditLog, cleanup := newTestAuditLog(t)
+	defer cleanup()
+
+	mc := defaultMuxConfig()
+	mc.auditLog = auditLog
+	handler := newServerMux(mc, gateway)
+
+	rr := serveTestRequest(t, handler, http.MethodPost, "/sign_messages", toJSON(t, SignMessagesRequest{
+		Messages: []SignMessageRequest{
+			{AddressN: 0, Message: "foo"},
+			{AddressN: 2, Message: "bar"},
+		},
+	}), map[string]string{
+		"Content-Type": ContentTypeJSON,
+	})
+	require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+
+	entries, err := auditLog.Entries(AuditFilter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "sign_messages", entries[0].Operation)
+	require.Equal(t, "Failure", entries[0].Result)
+	require.Equal(t, "message 1: Action cancelled by user", entries[0].Message)
+	require.Contains(t, string(entries[0].Request), `"message":"bar"`)
+}
+
+func TestSignMessagesResumed(t *testing.T) {
+	reqBody := toJSON(t, SignMessagesRequest{
+		Messages: []SignMessageRequest{
+			{AddressN: 0, Message: "foo"},
+			{AddressN: 2, Message: "bar"},
+		},
+	})
+	headers := map[string]string{
+		"Content-Type": ContentTypeJSON,
+	}
+
+	newHandler := func(t *testing.T) (*MockGatewayer, *AuditLog, func(), http.Handler) {
+		gateway := &MockGatewayer{}
+		gateway.On("GetFeatures").Return(testFeaturesMessage(t, "device-1", false), nil)
+		gateway.On("SignMessage", 0, "foo").Return(testPinMatrixRequestMessage(t, messages.PinMatrixRequestType_PinMatrixRequestType_Current), nil)
+
+		auditLog, cleanup := newTestAuditLog(t)
+
+		mc := defaultMuxConfig()
+		mc.auditLog = auditLog
+		handler := newServerMux(mc, gateway)
+
+		rr := serveTestRequest(t, handler, http.MethodPost, "/sign_messages", reqBody, headers)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.JSONEq(t, `{"data":["PinMatrixRequest"],"intermediate":{"kind":"pin_matrix","operation":"sign_messages","pin_matrix_request_type":"current"}}`, rr.Body.String())
+
+		// the batch is not recorded while it waits for the pin code
+		entries, err := auditLog.Entries(AuditFilter{})
+		require.NoError(t, err)
+		require.Empty(t, entries)
+
+		return gateway, auditLog, cleanup, handler
+	}
+
+	t.Run("signed after pin", func(t *testing.T) {
+		gateway, auditLog, cleanup, handler := newHandler(t)
+		defer cleanup()
+		gateway.On("PinMatrixAck", "1234").Return(testSignatureMessage(t, "sig-foo"), nil)
+		gateway.On("SignMessage", 2, "bar").Return(testSignatureMessage(t, "sig-bar"), nil)
+
+		rr := serveTestRequest(t, handler, http.MethodPost, "/intermediate/pin_matrix", `{"pin": "1234"}`, headers)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.JSONEq(t, toJSON(t, HTTPResponse{
+			Data: SignMessagesResponse{
+				Signatures: []string{"sig-foo", "sig-bar"},
+			},
+		}), rr.Body.String())
+		gateway.AssertNumberOfCalls(t, "SignMessage", 2)
+
+		entries, err := auditLog.Entries(AuditFilter{})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, "sign_messages", entries[0].Operation)
+		require.Equal(t, auditResultSuccess, entries[0].Result)
+	})
+
+	t.Run("second message rejected after pin", func(t *testing.T) {
+		gateway, auditLog, cleanup, handler := newHandler(t)
+		defer cleanup()
+		gateway.On("PinMatrixAck", "1234").Return(testSignatureMessage(t, "sig-foo"), nil)
+		gateway.On("SignMessage", 2, "bar").Return(testFailureMessage(t, "Action cancelled by user"), nil)
+
+		rr := serveTestRequest(t, handler, http.MethodPost, "/intermediate/pin_matrix", `{"pin": "1234"}`, headers)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+
+		var rsp ReceivedHTTPResponse
+		err := json.NewDecoder(rr.Body).Decode(&rsp)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"signatures":["sig-foo"],"failed":1}`, string(rsp.Data))
+
+		entries, err := auditLog.Entries(AuditFilter{})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, "Failure", entries[0].Result)
+		require.Equal(t, "message 1: Action cancelled by user", entries[0].Message)
+	})
+
+	t.Run("interrupted", func(t *testing.T) {
+		gateway, auditLog, cleanup, handler := newHandler(t)
+		defer cleanup()
+
+		rr := serveTestRequest(t, handler, http.MethodGet, "/features", "", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		gateway.AssertNotCalled(t, "SignMessage", 2, "bar")
+
+		entries, err := auditLog.Entries(AuditFilter{})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, auditResultInterrupted, entries[0].Result)
+	})
+}
@@ -0,0 +1,174 @@
+package api
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	"github.com/SkycoinProject/skycoin/src/cipher"
+
+	skyWallet "github.com/SkycoinProject/hardware-wallet-go/src/skywallet"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+)
+
+// messageHash returns the hash signed by the firmware for a message.
+// A message which is the hex encoding of a sha256 hash, such as a transaction
+// inner hash, is signed as is. Any other message is hashed first.
+func messageHash(message string) cipher.SHA256 {
+	if len(message) == 2*len(cipher.SHA256{}) {
+		if b, err := hex.DecodeString(message); err == nil {
+			return cipher.MustSHA256FromBytes(b)
+		}
+	}
+
+	return cipher.SumSHA256([]byte(message))
+}
+
+// recoverAddress returns the address which issued the signature of a message
+func recoverAddress(message, signature string) (cipher.Address, error) {
+	sig, err := cipher.SigFromHex(signature)
+	if err != nil {
+		return cipher.Address{}, err
+	}
+
+	pubKey, err := cipher.PubKeyFromSig(sig, messageHash(message))
+	if err != nil {
+		return cipher.Address{}, err
+	}
+
+	return cipher.AddressFromPubKey(pubKey), nil
+}
+
+// checkSignature recovers the address which issued the signature of a message
+// and compares it with the address derived by the device at addressN
+func checkSignature(gateway Gatewayer, addressN int, message, signature string) (string, error) {
+	recovered, err := recoverAddress(message, signature)
+	if err != nil {
+		return "", fmt.Errorf("signature self-check failed: %v", err)
+	}
+
+	msg, err := gateway.AddressGen(1, uint32(addressN), false)
+	if err != nil {
+		return "", fmt.Errorf("signature self-check failed: %v", err)
+	}
+
+	if msg.Kind != uint16(messages.MessageType_MessageType_ResponseSkycoinAddress) {
+		return "", fmt.Errorf("signature self-check failed: received unexpected response message type: %s", messages.MessageType(msg.Kind))
+	}
+
+	addresses, err := skyWallet.DecodeResponseSkycoinAddress(msg)
+	if err != nil {
+		return "", fmt.Errorf("signature self-check failed: %v", err)
+	}
+
+	if len(addresses) != 1 || addresses[0] != recovered.String() {
+		return "", fmt.Errorf("signature self-check failed: signature does not match the device address at index %d", addressN)
+	}
+
+	return recovered.String(), nil
+}
+
+// pendingSignature is a message signed by the device once the user confirms it
+type pendingSignature struct {
+	addressN int
+	message  string
+	armored  bool
+}
+
+// signatureCheck checks the signature of a message signing flow before it is returned.
+// The flow is kept pending while it waits for intermediate user input.
+type signatureCheck struct {
+	// version is the daemon version written in armored signed messages
+	version string
+	lock    sync.Mutex
+	pending *pendingSignature
+}
+
+func newSignatureCheck(version string) *signatureCheck {
+	return &signatureCheck{
+		version: version,
+	}
+}
+
+// begin starts tracking a message signing flow
+func (s *signatureCheck) begin(addressN int, message string, armored bool) {
+	s.lock.Lock()
+	s.pending = &pendingSignature{
+		addressN: addressN,
+		message:  message,
+		armored:  armored,
+	}
+	s.lock.Unlock()
+}
+
+// interrupt stops tracking the pending flow, it was replaced on the device by a new flow
+func (s *signatureCheck) interrupt() {
+	s.lock.Lock()
+	s.pending = nil
+	s.lock.Unlock()
+}
+
+// respond writes the firmware response of a device flow. A signature of the
+// pending flow is only written once it is checked against the device address.
+func (s *signatureCheck) respond(w http.ResponseWriter, gateway Gatewayer, msg wire.Message) {
+	if !s.resumeFlow(w, nil, gateway, msg) {
+		HandleFirmwareResponseMessages(w, msg)
+	}
+}
+
+// failFlow stops tracking the pending flow
+func (s *signatureCheck) failFlow(err error) {
+	s.interrupt()
+}
+
+// resumeFlow writes the signature of the pending flow once it is checked against the device address
+func (s *signatureCheck) resumeFlow(w http.ResponseWriter, r *http.Request, gateway Gatewayer, msg wire.Message) bool {
+	if isIntermediateMessage(msg) {
+		return false
+	}
+
+	s.lock.Lock()
+	pending := s.pending
+	s.pending = nil
+	s.lock.Unlock()
+
+	if pending == nil || msg.Kind != uint16(messages.MessageType_MessageType_ResponseSkycoinSignMessage) {
+		return false
+	}
+
+	signature, err := skyWallet.DecodeResponseSkycoinSignMessage(msg)
+	if err != nil {
+		resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+		writeHTTPResponse(w, resp)
+		return true
+	}
+
+	address, err := checkSignature(gateway, pending.addressN, pending.message, signature)
+	if err != nil {
+		logger.Error(err)
+		resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+		writeHTTPResponse(w, resp)
+		return true
+	}
+
+	resp := SignMessageResponse{
+		Signature: signature,
+		Address:   address,
+	}
+
+	if pending.armored {
+		resp.Armored = SignedMessage{
+			Message:   pending.message,
+			Address:   address,
+			Signature: signature,
+			Version:   s.version,
+		}.Armor()
+	}
+
+	writeHTTPResponse(w, HTTPResponse{
+		Data: resp,
+	})
+	return true
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"errors"
+	"strings"
+)
+
+// Lines delimiting an armored signed message
+const (
+	armorBegin     = "-----BEGIN SKYCOIN SIGNED MESSAGE-----"
+	armorSignature = "-----BEGIN SIGNATURE-----"
+	armorEnd       = "-----END SKYCOIN SIGNED MESSAGE-----"
+)
+
+// Headers of the signature block of an armored signed message
+const (
+	armorHeaderAddress   = "Address"
+	armorHeaderSignature = "Signature"
+	armorHeaderVersion   = "Version"
+)
+
+// SignedMessage is a message with its signature and the address which issued it
+type SignedMessage struct {
+	Message   string
+	Address   string
+	Signature string
+	// Version is the version of the daemon which produced the signed message
+	Version string
+}
+
+// Armor returns the signed message as a text block which can be copied between tools:
+//
+//  -----BEGIN SKYCOIN SIGNED MESSAGE-----
+//  <message>
+//  -----BEGIN SIGNATURE-----
+//  Address: <address>
+//  Signature: <signature>
+//  Version: <version>
+//  -----END SKYCOIN SIGNED MESSAGE-----
+//
+// Message lines starting with a dash are escaped with "- ", so they can't be read as a delimiter.
+func (m SignedMessage) Armor() string {
+	lines := []string{armorBegin}
+	for _, line := range strings.Split(m.Message, "\n") {
+		if strings.HasPrefix(line, "-") {
+			line = "- " + line
+		}
+		lines = append(lines, line)
+	}
+
+	lines = append(lines,
+		armorSignature,
+		armorHeaderAddress+": "+m.Address,
+		armorHeaderSignature+": "+m.Signature,
+	)
+	if m.Version != "" {
+		lines = append(lines, armorHeaderVersion+": "+m.Version)
+	}
+	lines = append(lines, armorEnd)
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// ParseArmoredMessage parses a signed message produced by SignedMessage.Armor.
+// Surrounding whitespace and CRLF line endings are accepted.
+func ParseArmoredMessage(armored string) (SignedMessage, error) {
+	armored = strings.Replace(armored, "\r\n", "\n", -1)
+	lines := strings.Split(strings.TrimSpace(armored), "\n")
+
+	if len(lines) < 2 || lines[0] != armorBegin {
+		return SignedMessage{}, errors.New("missing begin line")
+	}
+
+	if lines[len(lines)-1] != armorEnd {
+		return SignedMessage{}, errors.New("missing end line")
+	}
+	lines = lines[1 : len(lines)-1]
+
+	var messageLines []string
+	i := 0
+	for ; i < len(lines) && lines[i] != armorSignature; i++ {
+		line := lines[i]
+		if strings.HasPrefix(line, "- ") {
+			line = line[2:]
+		} else if strings.HasPrefix(line, "-") {
+			return SignedMessage{}, errors.New("message line starting with a dash is not escaped")
+		}
+		messageLines = append(messageLines, line)
+	}
+
+	if i == len(lines) {
+		return SignedMessage{}, errors.New("missing signature line")
+	}
+
+	m := SignedMessage{
+		Message: strings.Join(messageLines, "\n"),
+	}
+
+	for _, line := range lines[i+1:] {
+		kv := strings.SplitN(line, ":", 2)
+		if len(kv) != 2 {
+			return SignedMessage{}, errors.New("invalid header line")
+		}
+
+		value := strings.TrimSpace(kv[1])
+		switch kv[0] {
+		case armorHeaderAddress:
+			m.Address = value
+		case armorHeaderSignature:
+			m.Signature = value
+		case armorHeaderVersion:
+			m.Version = value
+		default:
+			return SignedMessage{}, errors.New("unknown header " + kv[0])
+		}
+	}
+
+	if m.Address == "" {
+		return SignedMessage{}, errors.New("missing address header")
+	}
+
+	if m.Signature == "" {
+		return SignedMessage{}, errors.New("missing signature header")
+	}
+
+	return m, nil
+}
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedMessageArmor(t *testing.T) {
+	m := SignedMessage{
+		Message:   testMessage,
+		Address:   testMessageAddress,
+		Signature: testMessageSignature,
+		Version:   "0.1.0",
+	}
+
+	armored := `-----BEGIN SKYCOIN SIGNED MESSAGE-----
+Hello World
+-----BEGIN SIGNATURE-----
+Address: 2EU3JbveHdkxW6z5tdhbbB2kRAWvXC2pLzw
+Signature: 6ebd63dd5e57cad07b6d229e96b5d2ac7d1bec1466d2a95bd200c21be6a0bf194b5ad5123f6e37c6393ee3635b38b938fcd91bbf1327fc957849a9e5736f6e4300
+Version: 0.1.0
+-----END SKYCOIN SIGNED MESSAGE-----
+`
+	require.Equal(t, armored, m.Armor())
+
+	parsed, err := ParseArmoredMessage(armored)
+	require.NoError(t, err)
+	require.Equal(t, m, parsed)
+}
+
+func TestParseArmoredMessage(t *testing.T) {
+	cases := []struct {
+		name     string
+		message  SignedMessage
+		armored  string
+		expected *SignedMessage
+		err      string
+	}{
+		{
+			name: "round trip with dashes and blank lines",
+			message: SignedMessage{
+				Message:   "-----BEGIN SIGNATURE-----\n\n- item\n-\ntrailing newline\n",
+				Address:   testMessageAddress,
+				Signature: testMessageSignature,
+			},
+		},
+
+		{
+			name:    "CRLF and surrounding whitespace",
+			armored: "\r\n  -----BEGIN SKYCOIN SIGNED MESSAGE-----\r\nfoo\r\nbar\r\n-----BEGIN SIGNATURE-----\r\nAddress: a\r\nSignature: s\r\n-----END SKYCOIN SIGNED MESSAGE-----\r\n",
+			expected: &SignedMessage{
+				Message:   "foo\nbar",
+				Address:   "a",
+				Signature: "s",
+			},
+		},
+
+		{
+			name:    "missing begin line",
+			armored: "foo\n-----BEGIN SIGNATURE-----\nAddress: a\nSignature: s\n-----END SKYCOIN SIGNED MESSAGE-----",
+			err:     "missing begin line",
+		},
+
+		{
+			name:    "missing end line",
+			armored: "-----BEGIN SKYCOIN SIGNED MESSAGE-----\nfoo\n-----BEGIN SIGNATURE-----\nAddress: a\nSignature: s",
+			err:     "missing end line",
+		},
+
+		{
+			name:    "missing signature line",
+			armored: "-----BEGIN SKYCOIN SIGNED MESSAGE-----\nfoo\nAddress: a\nSignature: s\n-----END SKYCOIN SIGNED MESSAGE-----",
+			err:     "missing signature line",
+		},
+
+		{
+			name:    "unescaped dash",
+			armored: "-----BEGIN SKYCOIN SIGNED MESSAGE-----\n-foo\n-----BEGIN SIGNATURE-----\nAddress: a\nSignature: s\n-----END SKYCOIN SIGNED MESSAGE-----",
+			err:     "message line starting with a dash is not escaped",
+		},
+
+		{
+			name:    "unknown header",
+			armored: "-----BEGIN SKYCOIN SIGNED MESSAGE-----\nfoo\n-----BEGIN SIGNATURE-----\nAddress: a\nSignature: s\nComment: c\n-----END SKYCOIN SIGNED MESSAGE-----",
+			err:     "unknown header Comment",
+		},
+
+		{
+			name:    "missing signature header",
+			armored: "-----BEGIN SKYCOIN SIGNED MESSAGE-----\nfoo\n-----BEGIN SIGNATURE-----\nAddress: a\n-----END SKYCOIN SIGNED MESSAGE-----",
+			err:     "missing signature header",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			armored := tc.armored
+			expected := tc.expected
+			if armored == "" {
+				armored = tc.message.Armor()
+				expected = &tc.message
+			}
+
+			m, err := ParseArmoredMessage(armored)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, *expected, m)
+		})
+	}
+}
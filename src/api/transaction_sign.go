@@ -25,6 +25,8 @@ type TransactionSignRequest struct {
 type TransactionInput struct {
 	Index *uint32 `json:"index"` // pointer to differentiate between 0 and nil
 	Hash  string  `json:"hash"`
+	// Address is resolved to its index on the device and can be used instead of Index
+	Address string `json:"address"`
 }
 
 // TransactionOutput is a skycoin transaction output
@@ -33,6 +35,9 @@ type TransactionOutput struct {
 	Address      string  `json:"address"`
 	Coins        string  `json:"coins"`
 	Hours        string  `json:"hours"`
+	// Change marks an output to an address of the device. If AddressIndex is not set,
+	// it is resolved from Address.
+	Change bool `json:"change,omitempty"`
 }
 
 // TransactionSignResponse is data returned by POST /api/v1/transaction_sign
@@ -43,7 +48,7 @@ type TransactionSignResponse struct {
 // URI: /api/v1/transactionSign
 // Method: POST
 // Args: JSON Body
-func transactionSign(gateway Gatewayer) http.HandlerFunc {
+func transactionSign(gateway Gatewayer, auditLog *AuditLog, lookup *addressLookup) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
@@ -71,6 +76,50 @@ func transactionSign(gateway Gatewayer) http.HandlerFunc {
 			return
 		}
 
+		// an address used by several inputs and change outputs is only looked up once
+		resolved := make(map[string]uint32)
+		resolve := func(address string) (uint32, bool) {
+			if index, ok := resolved[address]; ok {
+				return index, true
+			}
+
+			index, ok := lookup.resolve(w, r, address)
+			if ok {
+				resolved[address] = index
+			}
+			return index, ok
+		}
+
+		for i, input := range req.TransactionInputs {
+			if input.Address == "" {
+				continue
+			}
+
+			if input.Index != nil {
+				resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, "index and address cannot be used together")
+				writeHTTPResponse(w, resp)
+				return
+			}
+
+			index, ok := resolve(input.Address)
+			if !ok {
+				return
+			}
+			req.TransactionInputs[i].Index = &index
+		}
+
+		for i, output := range req.TransactionOutputs {
+			if !output.Change || output.AddressIndex != nil {
+				continue
+			}
+
+			index, ok := resolve(output.Address)
+			if !ok {
+				return
+			}
+			req.TransactionOutputs[i].AddressIndex = &index
+		}
+
 		txnInputs, txnOutputs, err := req.TransactionParams()
 		if err != nil {
 			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, err.Error())
@@ -89,6 +138,8 @@ func transactionSign(gateway Gatewayer) http.HandlerFunc {
 			}
 		}
 
+		auditEntry := auditLog.begin(r, gateway, "transaction_sign", newAuditTransactionSign(req))
+
 		var msg wire.Message
 		retCH := make(chan int)
 		errCH := make(chan int)
@@ -105,12 +156,15 @@ func transactionSign(gateway Gatewayer) http.HandlerFunc {
 
 		select {
 		case <-retCH:
+			auditLog.finish(auditEntry, msg)
 			HandleFirmwareResponseMessages(w, msg)
 		case <-errCH:
 			logger.Errorf("transactionSign failed: %s", err.Error())
+			auditLog.finishWithResult(auditEntry, auditResultError, err.Error())
 			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
 			writeHTTPResponse(w, resp)
 		case <-ctx.Done():
+			auditLog.finishWithResult(auditEntry, auditResultClosed, "")
 			disConnErr := gateway.Disconnect()
 			if disConnErr != nil {
 				resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
@@ -72,7 +72,7 @@ func TestSignTransaction(t *testing.T) {
 			status:      http.StatusBadRequest,
 			httpBody: toJSON(t, &TransactionSignRequest{
 				TransactionInputs: []TransactionInput{
-					{newUint32Ptr(0), ""}, {newUint32Ptr(1), ""},
+					{Index: newUint32Ptr(0), Hash: ""}, {Index: newUint32Ptr(1), Hash: ""},
 				},
 				TransactionOutputs: []TransactionOutput{
 					{Address: "2M9hQ4LqEsBF5JZ3uBatnkaMgg9pN965JvG", Coins: "2", Hours: "2"},
@@ -90,8 +90,8 @@ func TestSignTransaction(t *testing.T) {
 			status:      http.StatusBadRequest,
 			httpBody: toJSON(t, &TransactionSignRequest{
 				TransactionInputs: []TransactionInput{
-					{newUint32Ptr(0), "c2244e4912330d201d979f80db4df42118e49704e500e2e00a52a61954e8c663"},
-					{newUint32Ptr(1), "4f7250b0b1f588c4dedd5a4be984fab7215a773773480d8698e8f5ff04ef2611"},
+					{Index: newUint32Ptr(0), Hash: "c2244e4912330d201d979f80db4df42118e49704e500e2e00a52a61954e8c663"},
+					{Index: newUint32Ptr(1), Hash: "4f7250b0b1f588c4dedd5a4be984fab7215a773773480d8698e8f5ff04ef2611"},
 				},
 				TransactionOutputs: []TransactionOutput{
 					{Address: "2M9hQ4LqEsBF5JZ3uBatnkaMgg9pN965JvG", Hours: "2"},
@@ -108,8 +108,8 @@ func TestSignTransaction(t *testing.T) {
 			status:      http.StatusBadRequest,
 			httpBody: toJSON(t, &TransactionSignRequest{
 				TransactionInputs: []TransactionInput{
-					{newUint32Ptr(0), "c2244e4912330d201d979f80db4df42118e49704e500e2e00a52a61954e8c663"},
-					{newUint32Ptr(1), "4f7250b0b1f588c4dedd5a4be984fab7215a773773480d8698e8f5ff04ef2611"},
+					{Index: newUint32Ptr(0), Hash: "c2244e4912330d201d979f80db4df42118e49704e500e2e00a52a61954e8c663"},
+					{Index: newUint32Ptr(1), Hash: "4f7250b0b1f588c4dedd5a4be984fab7215a773773480d8698e8f5ff04ef2611"},
 				},
 				TransactionOutputs: []TransactionOutput{
 					{Address: "2M9hQ4LqEsBF5JZ3uBatnkaMgg9pN965JvG", Coins: "2"},
@@ -126,8 +126,8 @@ func TestSignTransaction(t *testing.T) {
 			status:      http.StatusBadRequest,
 			httpBody: toJSON(t, &TransactionSignRequest{
 				TransactionInputs: []TransactionInput{
-					{newUint32Ptr(0), "c2244e4912330d201d979f80db4df42118e49704e500e2e00a52a61954e8c663"},
-					{newUint32Ptr(1), "4f7250b0b1f588c4dedd5a4be984fab7215a773773480d8698e8f5ff04ef2611"},
+					{Index: newUint32Ptr(0), Hash: "c2244e4912330d201d979f80db4df42118e49704e500e2e00a52a61954e8c663"},
+					{Index: newUint32Ptr(1), Hash: "4f7250b0b1f588c4dedd5a4be984fab7215a773773480d8698e8f5ff04ef2611"},
 				},
 				TransactionOutputs: []TransactionOutput{
 					{Coins: "2", Hours: "2"},
@@ -144,8 +144,8 @@ func TestSignTransaction(t *testing.T) {
 			status:      http.StatusUnprocessableEntity,
 			httpBody: toJSON(t, &TransactionSignRequest{
 				TransactionInputs: []TransactionInput{
-					{newUint32Ptr(0), "c2244e4912330d201d979f80db4df42118e49704e500e2e00a52a61954e8c663"},
-					{newUint32Ptr(1), "4f7250b0b1f588c4dedd5a4be984fab7215a773773480d8698e8f5ff04ef2611"},
+					{Index: newUint32Ptr(0), Hash: "c2244e4912330d201d979f80db4df42118e49704e500e2e00a52a61954e8c663"},
+					{Index: newUint32Ptr(1), Hash: "4f7250b0b1f588c4dedd5a4be984fab7215a773773480d8698e8f5ff04ef2611"},
 				},
 				TransactionOutputs: []TransactionOutput{
 					{Address: "2M9hQ4LqEsas5JZ3uBatnkaMgg9pN965JvG", Coins: "2", Hours: "2"},
@@ -162,8 +162,8 @@ func TestSignTransaction(t *testing.T) {
 			status:      http.StatusUnprocessableEntity,
 			httpBody: toJSON(t, &TransactionSignRequest{
 				TransactionInputs: []TransactionInput{
-					{newUint32Ptr(0), "c2244e4912330d201d979f80db4df42118e49704e500e2e00a52a61954e8c663"},
-					{newUint32Ptr(1), "4f7250b0b1f588c4dedd5a4be984fab7215a773773480d8698e8f5ff04ef2611"},
+					{Index: newUint32Ptr(0), Hash: "c2244e4912330d201d979f80db4df42118e49704e500e2e00a52a61954e8c663"},
+					{Index: newUint32Ptr(1), Hash: "4f7250b0b1f588c4dedd5a4be984fab7215a773773480d8698e8f5ff04ef2611"},
 				},
 				TransactionOutputs: []TransactionOutput{
 					{Address: "2M9hQ4LqEsBF5JZ3uBatnkaMgg9pN965JvG", Coins: "0.000000001010111001", Hours: "2"},
@@ -180,8 +180,8 @@ func TestSignTransaction(t *testing.T) {
 			status:      http.StatusUnprocessableEntity,
 			httpBody: toJSON(t, &TransactionSignRequest{
 				TransactionInputs: []TransactionInput{
-					{newUint32Ptr(0), "c2244e4912330d201d979f80db4df42118e49704e500e2e00a52a61954e8c663"},
-					{newUint32Ptr(1), "4f7250b0b1f588c4dedd5a4be984fab7215a773773480d8698e8f5ff04ef2611"},
+					{Index: newUint32Ptr(0), Hash: "c2244e4912330d201d979f80db4df42118e49704e500e2e00a52a61954e8c663"},
+					{Index: newUint32Ptr(1), Hash: "4f7250b0b1f588c4dedd5a4be984fab7215a773773480d8698e8f5ff04ef2611"},
 				},
 				TransactionOutputs: []TransactionOutput{
 					{Address: "2M9hQ4LqEsBF5JZ3uBatnkaMgg9pN965JvG", Coins: "1", Hours: "0.2"},
@@ -198,8 +198,8 @@ func TestSignTransaction(t *testing.T) {
 			status:      http.StatusConflict,
 			httpBody: toJSON(t, &TransactionSignRequest{
 				TransactionInputs: []TransactionInput{
-					{newUint32Ptr(0), "c2244e4912330d201d979f80db4df42118e49704e500e2e00a52a61954e8c663"},
-					{newUint32Ptr(1), "4f7250b0b1f588c4dedd5a4be984fab7215a773773480d8698e8f5ff04ef2611"},
+					{Index: newUint32Ptr(0), Hash: "c2244e4912330d201d979f80db4df42118e49704e500e2e00a52a61954e8c663"},
+					{Index: newUint32Ptr(1), Hash: "4f7250b0b1f588c4dedd5a4be984fab7215a773773480d8698e8f5ff04ef2611"},
 				},
 				TransactionOutputs: []TransactionOutput{
 					{Address: "2M9hQ4LqEsBF5JZ3uBatnkaMgg9pN965JvG", Coins: "2", Hours: "2"},
@@ -211,7 +211,7 @@ func TestSignTransaction(t *testing.T) {
 				Data: failureMsgBytes,
 			},
 			err:          "failure msg",
-			httpResponse: NewHTTPErrorResponse(http.StatusConflict, "failure msg"),
+			httpResponse: newTypedHTTPErrorResponse(http.StatusConflict, ErrorTypeNotInitialized, "failure msg"),
 		},
 
 		{
@@ -221,8 +221,8 @@ func TestSignTransaction(t *testing.T) {
 			status:      http.StatusOK,
 			httpBody: toJSON(t, &TransactionSignRequest{
 				TransactionInputs: []TransactionInput{
-					{nil, "c2244e4912330d201d979f80db4df42118e49704e500e2e00a52a61954e8c663"},
-					{nil, "4f7250b0b1f588c4dedd5a4be984fab7215a773773480d8698e8f5ff04ef2611"},
+					{Index: nil, Hash: "c2244e4912330d201d979f80db4df42118e49704e500e2e00a52a61954e8c663"},
+					{Index: nil, Hash: "4f7250b0b1f588c4dedd5a4be984fab7215a773773480d8698e8f5ff04ef2611"},
 				},
 				TransactionOutputs: []TransactionOutput{
 					{Address: "2M9hQ4LqEsBF5JZ3uBatnkaMgg9pN965JvG", Coins: "2", Hours: "2"},
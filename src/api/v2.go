@@ -0,0 +1,451 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+)
+
+// Device is a device of /api/v2/devices
+type Device struct {
+	// ID is the device id of the features, used in the resource paths of the device
+	ID       string             `json:"id"`
+	Features *messages.Features `json:"features"`
+}
+
+// DevicesResponse is data returned by GET /api/v2/devices
+type DevicesResponse struct {
+	Devices []Device `json:"devices"`
+}
+
+// MessageResponse is data returned by /api/v2 actions which end with a firmware success message
+type MessageResponse struct {
+	Message string `json:"message"`
+}
+
+// AddressesResponse is data returned by POST /api/v2/devices/{id}/addresses
+type AddressesResponse struct {
+	Addresses []string `json:"addresses"`
+}
+
+// RecoveredAddressResponse is data returned by POST /api/v2/devices/{id}/messages/verify
+type RecoveredAddressResponse struct {
+	Address string `json:"address"`
+}
+
+// v2Route maps a resource of a device in /api/v2 to the /api/v1 endpoint which serves it
+type v2Route struct {
+	method string
+	// resource is relative to /api/v2/devices/{id}, it is empty for the device itself
+	resource   string
+	v1Method   string
+	v1Endpoint string
+	// startsFlow is set for the resources which start a new device flow. The device id of the other
+	// resources is checked against the last known device id, to not interrupt the flow on the device.
+	startsFlow bool
+	// data converts the data of the /api/v1 response, it is returned as-is if nil
+	data func(json.RawMessage) (interface{}, error)
+}
+
+var v2Routes = []v2Route{
+	{http.MethodGet, "", http.MethodGet, "/features", true, nil},
+	{http.MethodPost, "addresses", http.MethodPost, "/generate_addresses", true, v2Addresses},
+	{http.MethodPost, "settings", http.MethodPost, "/apply_settings", true, v2Message},
+	{http.MethodPost, "backup", http.MethodPost, "/backup", true, v2Message},
+	{http.MethodPost, "cancel", http.MethodPut, "/cancel", false, v2Message},
+	{http.MethodPost, "mnemonic", http.MethodPost, "/set_mnemonic", true, v2Message},
+	{http.MethodPost, "mnemonic/generate", http.MethodPost, "/generate_mnemonic", true, v2Message},
+	{http.MethodPost, "recovery", http.MethodPost, "/recovery", true, v2Message},
+	{http.MethodPost, "pin", http.MethodPost, "/configure_pin_code", true, v2Message},
+	{http.MethodPost, "messages/sign", http.MethodPost, "/sign_message", true, nil},
+	{http.MethodPost, "messages/sign/batch", http.MethodPost, "/sign_messages", true, nil},
+	{http.MethodPost, "messages/verify", http.MethodPost, "/check_message_signature", true, v2RecoveredAddress},
+	{http.MethodPost, "messages/verify/armored", http.MethodPost, "/check_message_signature/armored", true, v2RecoveredAddress},
+	{http.MethodPost, "transactions/sign", http.MethodPost, "/transaction_sign", true, v2Signatures},
+	{http.MethodPost, "wipe", http.MethodDelete, "/wipe", true, v2Message},
+	{http.MethodGet, "addresses", http.MethodGet, "/addresses", false, nil},
+	{http.MethodPost, "addresses/verify", http.MethodPost, "/verify_address", true, nil},
+	{http.MethodPost, "addresses/index", http.MethodPost, "/address_index", true, nil},
+	{http.MethodPost, "addresses/discover", http.MethodPost, "/account_discovery", true, nil},
+	{http.MethodGet, "backup/verify", http.MethodGet, "/backup/verify", false, nil},
+	{http.MethodPost, "backup/verify", http.MethodPost, "/backup/verify", true, nil},
+	{http.MethodPost, "intermediate/pin_matrix", http.MethodPost, "/intermediate/pin_matrix", false, nil},
+	{http.MethodPost, "intermediate/passphrase", http.MethodPost, "/intermediate/passphrase", false, nil},
+	{http.MethodPost, "intermediate/word", http.MethodPost, "/intermediate/word", false, nil},
+	{http.MethodPost, "intermediate/button", http.MethodPost, "/intermediate/button", false, nil},
+	{http.MethodPost, "intermediate/passphrase_state", http.MethodPost, "/intermediate/passphrase_state", false, nil},
+}
+
+// v2ExcludedEndpoints are the /api/v1 endpoints which have no /api/v2 resource, with the reason
+var v2ExcludedEndpoints = map[string]string{
+	"/available":            "the availability of a device is the device list of GET /api/v2/devices",
+	"/firmware_update":      "the firmware is uploaded as a multipart form, not a JSON body",
+	"/entropy/raw":          "the entropy is streamed as binary data, not JSON",
+	"/entropy/mixed":        "the entropy is streamed as binary data, not JSON",
+	"/entropy/report":       "the report reads a large amount of entropy in one request, it is a diagnostic of the daemon",
+	"/addresses/":           "the QR code of an address is an image, not JSON",
+	"/provision":            "provisioning sets up a new device which has no device id yet",
+	"/provision/resume":     "provisioning sets up a new device which has no device id yet",
+	"/emulator/load_device": "the emulator is loaded by the integration test fixtures",
+	"/mnemonic/validate":    "the mnemonic is checked by the daemon, without the device",
+	"/mnemonic/words":       "the word list is served by the daemon, without the device",
+	"/audit":                "the audit log is kept by the daemon, without the device",
+	"/version":              "the version is the daemon's, not the device's",
+	"/openapi.json":         "the spec describes the REST API",
+	"/rpc":                  "it is the JSON-RPC endpoint",
+	"/csrf":                 "the CSRF token is shared by every API version",
+}
+
+// v2Handler serves /api/v2 with the handlers of /api/v1. Requests are sent to their /api/v1 endpoint,
+// the response data is converted to a typed object and intermediate operations are named by their resource.
+type v2Handler struct {
+	v1         http.Handler
+	gateway    Gatewayer
+	operations *operationTracker
+
+	lock     sync.Mutex
+	deviceID string
+}
+
+func newV2Handler(v1 http.Handler, gateway Gatewayer, operations *operationTracker) *v2Handler {
+	return &v2Handler{
+		v1:         v1,
+		gateway:    gateway,
+		operations: operations,
+	}
+}
+
+// URI: /api/v2/devices
+// URI: /api/v2/devices/{id}/{resource}
+func (h *v2Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/api/"+apiVersion2)
+	if path == "/devices" {
+		h.devices(w, r)
+		return
+	}
+
+	if !strings.HasPrefix(path, "/devices/") {
+		writeHTTPResponse(w, NewHTTPErrorResponse(http.StatusNotFound, ""))
+		return
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(path, "/devices/"), "/", 2)
+	id := parts[0]
+	var resource string
+	if len(parts) == 2 {
+		resource = strings.TrimSuffix(parts[1], "/")
+	}
+
+	route, found := findV2Route(r.Method, resource)
+	if route == nil {
+		if found {
+			writeHTTPResponse(w, NewHTTPErrorResponse(http.StatusMethodNotAllowed, ""))
+		} else {
+			writeHTTPResponse(w, NewHTTPErrorResponse(http.StatusNotFound, ""))
+		}
+		return
+	}
+
+	if ok, err := h.checkDeviceID(id, route.startsFlow); err != nil {
+		writeHTTPResponse(w, NewHTTPErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	} else if !ok {
+		writeHTTPResponse(w, NewHTTPErrorResponse(http.StatusNotFound, "device not found"))
+		return
+	}
+
+	h.serveV1(w, r, *route)
+}
+
+// devices lists the connected device
+func (h *v2Handler) devices(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeHTTPResponse(w, NewHTTPErrorResponse(http.StatusMethodNotAllowed, ""))
+		return
+	}
+
+	devices := []Device{}
+	if h.gateway.Available() {
+		features, err := deviceFeatures(h.gateway)
+		if err != nil {
+			writeHTTPResponse(w, NewHTTPErrorResponse(http.StatusInternalServerError, err.Error()))
+			return
+		}
+
+		h.setDeviceID(features.GetDeviceId())
+		devices = append(devices, Device{
+			ID:       features.GetDeviceId(),
+			Features: features,
+		})
+	}
+
+	writeHTTPResponse(w, HTTPResponse{
+		Data: DevicesResponse{
+			Devices: devices,
+		},
+	})
+}
+
+// findV2Route returns the route of a resource and method. found is true if the resource exists.
+func findV2Route(method, resource string) (route *v2Route, found bool) {
+	for i := range v2Routes {
+		if v2Routes[i].resource != resource {
+			continue
+		}
+
+		found = true
+		if v2Routes[i].method == method {
+			return &v2Routes[i], true
+		}
+	}
+
+	return nil, found
+}
+
+// v2RouteOfOperation returns the route of a /api/v1 operation, the operation of an intermediate response
+func v2RouteOfOperation(operation string) *v2Route {
+	for i := range v2Routes {
+		if v2Routes[i].startsFlow && strings.Trim(v2Routes[i].v1Endpoint, "/") == operation {
+			return &v2Routes[i]
+		}
+	}
+
+	return nil
+}
+
+// checkDeviceID checks the device id of a request. The device features are read again if the device
+// is not the last known one, unless that would interrupt a flow waiting for user input.
+func (h *v2Handler) checkDeviceID(id string, startsFlow bool) (bool, error) {
+	if id == "" {
+		return false, nil
+	}
+
+	h.lock.Lock()
+	known := h.deviceID
+	h.lock.Unlock()
+
+	if id == known || !startsFlow {
+		return id == known, nil
+	}
+
+	features, err := deviceFeatures(h.gateway)
+	if err != nil {
+		return false, err
+	}
+
+	h.setDeviceID(features.GetDeviceId())
+	return id == features.GetDeviceId(), nil
+}
+
+func (h *v2Handler) setDeviceID(id string) {
+	h.lock.Lock()
+	h.deviceID = id
+	h.lock.Unlock()
+}
+
+// serveV1 serves a request with its /api/v1 endpoint and writes the converted response
+func (h *v2Handler) serveV1(w http.ResponseWriter, r *http.Request, route v2Route) {
+	v1r := newV1Request(r, route.v1Method, route.v1Endpoint)
+	v1r.URL.RawQuery = r.URL.RawQuery
+	v1r.RequestURI = v1r.URL.RequestURI()
+
+	rec := newBufferedResponseWriter()
+	h.v1.ServeHTTP(rec, v1r)
+
+	var v1Resp ReceivedHTTPResponse
+	if err := json.Unmarshal(rec.body.Bytes(), &v1Resp); err != nil {
+		writeHTTPResponse(w, NewHTTPErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	resp := HTTPResponse{
+		Error: v1Resp.Error,
+	}
+
+	switch {
+	case v1Resp.Intermediate != nil:
+		resp.Intermediate = v1Resp.Intermediate
+		if opRoute := v2RouteOfOperation(resp.Intermediate.Operation); opRoute != nil {
+			resp.Intermediate.Operation = opRoute.resource
+		}
+	case len(v1Resp.Data) != 0:
+		// the response of an intermediate request is the response of the operation it continues
+		dataRoute := &route
+		if strings.HasPrefix(route.resource, "intermediate/") {
+			if opRoute := v2RouteOfOperation(h.operations.get()); opRoute != nil {
+				dataRoute = opRoute
+			}
+		}
+
+		if dataRoute.data == nil || v1Resp.Error != nil {
+			resp.Data = v1Resp.Data
+			break
+		}
+
+		data, err := dataRoute.data(v1Resp.Data)
+		if err != nil {
+			// the data is not the message type array of the resource
+			resp.Data = v1Resp.Data
+			break
+		}
+		resp.Data = data
+	}
+
+	writeHTTPResponse(w, resp)
+}
+
+func v2Message(data json.RawMessage) (interface{}, error) {
+	var v []string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+
+	var message string
+	if len(v) > 0 {
+		message = v[0]
+	}
+
+	return MessageResponse{
+		Message: message,
+	}, nil
+}
+
+func v2Addresses(data json.RawMessage) (interface{}, error) {
+	var v []string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+
+	return AddressesResponse{
+		Addresses: v,
+	}, nil
+}
+
+func v2Signatures(data json.RawMessage) (interface{}, error) {
+	var v []string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+
+	return TransactionSignResponse{
+		Signatures: &v,
+	}, nil
+}
+
+func v2RecoveredAddress(data json.RawMessage) (interface{}, error) {
+	var v []string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+
+	var address string
+	if len(v) > 0 {
+		address = v[0]
+	}
+
+	return RecoveredAddressResponse{
+		Address: address,
+	}, nil
+}
+
+// newV1Request copies a request to an /api/v1 endpoint, with the headers of the request.
+// The response is converted, so it is neither compressed nor streamed.
+func newV1Request(r *http.Request, method, endpoint string) *http.Request {
+	v1r := new(http.Request)
+	*v1r = *r
+	v1r.Method = method
+	v1r.URL = &url.URL{
+		Path: "/api/" + apiVersion1 + endpoint,
+	}
+	v1r.RequestURI = v1r.URL.RequestURI()
+
+	v1r.Header = make(http.Header, len(r.Header))
+	for k, v := range r.Header {
+		v1r.Header[k] = v
+	}
+	v1r.Header.Del("Accept-Encoding")
+	v1r.Header.Del("Accept")
+
+	return v1r
+}
+
+// withErrorTypes sets the type of the errors written by handler which have none, from their status code.
+// Every error of /api/v2 has a type.
+func withErrorTypes(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := newBufferedResponseWriter()
+		handler.ServeHTTP(rec, r)
+
+		for k, v := range rec.header {
+			w.Header()[k] = v
+		}
+
+		var resp ReceivedHTTPResponse
+		if rec.status >= 400 && json.Unmarshal(rec.body.Bytes(), &resp) == nil && resp.Error != nil && resp.Error.Type == "" {
+			resp.Error.Type = errorTypeOfStatus(resp.Error.Code)
+
+			typed := HTTPResponse{
+				Error:        resp.Error,
+				Intermediate: resp.Intermediate,
+			}
+			if len(resp.Data) != 0 && string(resp.Data) != "null" {
+				typed.Data = resp.Data
+			}
+
+			w.Header().Del("Content-Type")
+			w.Header().Del("Content-Length")
+			writeHTTPResponse(w, typed)
+			return
+		}
+
+		w.WriteHeader(rec.status)
+		if _, err := w.Write(rec.body.Bytes()); err != nil {
+			logger.WithError(err).Error("http Write failed")
+		}
+	})
+}
+
+// errorTypeOfStatus returns the error type of an HTTP status, e.g. method_not_allowed
+func errorTypeOfStatus(code int) string {
+	if code == 499 {
+		return "client_closed_request"
+	}
+
+	text := http.StatusText(code)
+	if text == "" {
+		return ErrorTypeFailure
+	}
+
+	return strings.Replace(strings.ToLower(text), " ", "_", -1)
+}
+
+// bufferedResponseWriter keeps a response to rewrite it
+type bufferedResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newBufferedResponseWriter() *bufferedResponseWriter {
+	return &bufferedResponseWriter{
+		header: make(http.Header),
+		status: http.StatusOK,
+	}
+}
+
+func (b *bufferedResponseWriter) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponseWriter) WriteHeader(status int) {
+	b.status = status
+}
+
+func (b *bufferedResponseWriter) Write(data []byte) (int, error) {
+	return b.body.Write(data)
+}
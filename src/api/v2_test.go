@@ -0,0 +1,352 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+	"github.com/stretchr/testify/require"
+)
+
+const testDeviceID = "device-1"
+
+func serveV2TestRequest(t *testing.T, handler http.Handler, method, endpoint, body string, headers map[string]string) *httptest.ResponseRecorder {
+	req, err := http.NewRequest(method, "/api/v2"+endpoint, strings.NewReader(body))
+	require.NoError(t, err)
+
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestV2Devices(t *testing.T) {
+	cases := []struct {
+		name      string
+		method    string
+		available bool
+		status    int
+		response  string
+	}{
+		{
+			name:     "405",
+			method:   http.MethodPost,
+			status:   http.StatusMethodNotAllowed,
+			response: `{"error":{"code":405,"message":"Method Not Allowed","type":"method_not_allowed"}}`,
+		},
+
+		{
+			name:     "200 - no device",
+			method:   http.MethodGet,
+			status:   http.StatusOK,
+			response: `{"data":{"devices":[]}}`,
+		},
+
+		{
+			name:      "200",
+			method:    http.MethodGet,
+			available: true,
+			status:    http.StatusOK,
+			response:  fmt.Sprintf(`{"data":{"devices":[{"id":"%s","features":{"device_id":"%s","passphrase_protection":false}}]}}`, testDeviceID, testDeviceID),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+			gateway.On("Available").Return(tc.available)
+			gateway.On("GetFeatures").Return(testFeaturesMessage(t, testDeviceID, false), nil)
+
+			handler := newServerMux(defaultMuxConfig(), gateway)
+
+			rr := serveV2TestRequest(t, handler, tc.method, "/devices", "", nil)
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+			require.JSONEq(t, tc.response, rr.Body.String())
+		})
+	}
+}
+
+func TestV2DeviceResources(t *testing.T) {
+	cases := []struct {
+		name       string
+		method     string
+		endpoint   string
+		httpBody   string
+		gatewayFn  string
+		gatewayArg []interface{}
+		result     interface{}
+		// routed is false for requests which are rejected before the device is read
+		routed   bool
+		status   int
+		response string
+	}{
+		{
+			name:     "404 - unknown resource",
+			method:   http.MethodPost,
+			endpoint: "/devices/device-1/foo",
+			status:   http.StatusNotFound,
+			response: `{"error":{"code":404,"message":"Not Found","type":"not_found"}}`,
+		},
+
+		{
+			name:     "404 - unknown path",
+			method:   http.MethodGet,
+			endpoint: "/foo",
+			status:   http.StatusNotFound,
+			response: `{"error":{"code":404,"message":"Not Found","type":"not_found"}}`,
+		},
+
+		{
+			name:     "405",
+			method:   http.MethodGet,
+			endpoint: "/devices/device-1/wipe",
+			status:   http.StatusMethodNotAllowed,
+			response: `{"error":{"code":405,"message":"Method Not Allowed","type":"method_not_allowed"}}`,
+		},
+
+		{
+			name:     "404 - unknown device",
+			method:   http.MethodPost,
+			endpoint: "/devices/device-2/wipe",
+			routed:   true,
+			status:   http.StatusNotFound,
+			response: `{"error":{"code":404,"message":"device not found","type":"not_found"}}`,
+		},
+
+		{
+			name:     "200 - device",
+			method:   http.MethodGet,
+			endpoint: "/devices/device-1",
+			routed:   true,
+			status:   http.StatusOK,
+			response: `{"data":{"device_id":"device-1","passphrase_protection":false}}`,
+		},
+
+		{
+			name:       "200 - addresses",
+			method:     http.MethodPost,
+			endpoint:   "/devices/device-1/addresses",
+			httpBody:   `{"address_n":2}`,
+			gatewayFn:  "AddressGen",
+			gatewayArg: []interface{}{uint32(2), uint32(0), false},
+			result:     testAddressesMessage(t, "2EU3JbveHdkxW6z5tdhbbB2kRAWvXC2pLzw", "zC8GAQGQBfwk7vtTxVoRG7iMperHNuyYPs"),
+			routed:     true,
+			status:     http.StatusOK,
+			response:   `{"data":{"addresses":["2EU3JbveHdkxW6z5tdhbbB2kRAWvXC2pLzw","zC8GAQGQBfwk7vtTxVoRG7iMperHNuyYPs"]}}`,
+		},
+
+		{
+			name:     "422 - addresses",
+			method:   http.MethodPost,
+			endpoint: "/devices/device-1/addresses",
+			httpBody: `{"address_n":-1}`,
+			routed:   true,
+			status:   http.StatusUnprocessableEntity,
+			response: `{"error":{"code":422,"message":"address_n cannot be negative","type":"unprocessable_entity"}}`,
+		},
+
+		{
+			name:      "200 - wipe",
+			method:    http.MethodPost,
+			endpoint:  "/devices/device-1/wipe",
+			gatewayFn: "Wipe",
+			result:    testSuccessMessage(t, "Device wiped"),
+			routed:    true,
+			status:    http.StatusOK,
+			response:  `{"data":{"message":"Device wiped"}}`,
+		},
+
+		{
+			name:      "409 - wipe failure",
+			method:    http.MethodPost,
+			endpoint:  "/devices/device-1/wipe",
+			gatewayFn: "Wipe",
+			result:    testFailureMessage(t, "Action cancelled by user"),
+			routed:    true,
+			status:    http.StatusConflict,
+			response:  `{"error":{"code":409,"message":"Action cancelled by user","type":"action_cancelled"}}`,
+		},
+
+		{
+			name:       "200 - messages sign batch",
+			method:     http.MethodPost,
+			endpoint:   "/devices/device-1/messages/sign/batch",
+			httpBody:   `{"messages":[{"address_n":0,"message":"foo"}]}`,
+			gatewayFn:  "SignMessage",
+			gatewayArg: []interface{}{0, "foo"},
+			result:     testSignatureMessage(t, "sig-foo"),
+			routed:     true,
+			status:     http.StatusOK,
+			response:   `{"data":{"signatures":["sig-foo"]}}`,
+		},
+
+		{
+			name:     "200 - messages verify armored",
+			method:   http.MethodPost,
+			endpoint: "/devices/device-1/messages/verify/armored",
+			httpBody: toJSON(t, CheckArmoredMessageSignatureRequest{
+				Armored: SignedMessage{
+					Message:   testMessage,
+					Address:   testMessageAddress,
+					Signature: testMessageSignature,
+					Version:   "0.1.0",
+				}.Armor(),
+				Offline: true,
+			}),
+			routed:   true,
+			status:   http.StatusOK,
+			response: `{"data":{"address":"` + testMessageAddress + `"}}`,
+		},
+
+		{
+			name:     "422 - addresses index",
+			method:   http.MethodPost,
+			endpoint: "/devices/device-1/addresses/index",
+			httpBody: `{"address":"foo"}`,
+			routed:   true,
+			status:   http.StatusUnprocessableEntity,
+			response: `{"error":{"code":422,"message":"invalid address: Invalid address length","type":"unprocessable_entity"}}`,
+		},
+
+		{
+			name:       "200 - pin",
+			method:     http.MethodPost,
+			endpoint:   "/devices/device-1/pin",
+			httpBody:   `{"remove_pin":true}`,
+			gatewayFn:  "ChangePin",
+			gatewayArg: []interface{}{newBoolPtr(true)},
+			result:     testSuccessMessage(t, "PIN removed"),
+			routed:     true,
+			status:     http.StatusOK,
+			response:   `{"data":{"message":"PIN removed"}}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+			if tc.routed {
+				gateway.On("GetFeatures").Return(testFeaturesMessage(t, testDeviceID, false), nil)
+			}
+			if tc.gatewayFn != "" {
+				gateway.On(tc.gatewayFn, tc.gatewayArg...).Return(tc.result, nil)
+			}
+
+			handler := newServerMux(defaultMuxConfig(), gateway)
+
+			rr := serveV2TestRequest(t, handler, tc.method, tc.endpoint, tc.httpBody, map[string]string{
+				"Content-Type": ContentTypeJSON,
+			})
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+			require.JSONEq(t, tc.response, rr.Body.String())
+
+			gateway.AssertExpectations(t)
+		})
+	}
+}
+
+func TestV2Intermediate(t *testing.T) {
+	gateway := &MockGatewayer{}
+	gateway.On("GetFeatures").Return(testFeaturesMessage(t, testDeviceID, false), nil).Once()
+	gateway.On("Wipe").Return(testButtonRequestMessage(t, messages.ButtonRequestType_ButtonRequest_WipeDevice), nil)
+	gateway.On("ButtonAck").Return(testSuccessMessage(t, "Device wiped"), nil)
+
+	handler := newServerMux(defaultMuxConfig(), gateway)
+
+	// the device is not known yet, it is not read to not interrupt a flow
+	rr := serveV2TestRequest(t, handler, http.MethodPost, "/devices/device-1/intermediate/button", "", nil)
+	require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+
+	rr = serveV2TestRequest(t, handler, http.MethodPost, "/devices/device-1/wipe", "", nil)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	require.JSONEq(t, `{"intermediate":{"kind":"button","operation":"wipe","button_request_type":"wipe_device"}}`, rr.Body.String())
+
+	// the response of the intermediate request is converted like the response of the wipe
+	rr = serveV2TestRequest(t, handler, http.MethodPost, "/devices/device-1/intermediate/button", "", nil)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	require.JSONEq(t, `{"data":{"message":"Device wiped"}}`, rr.Body.String())
+
+	gateway.AssertExpectations(t)
+}
+
+func TestV2CSRF(t *testing.T) {
+	gateway := &MockGatewayer{}
+
+	cfg := defaultMuxConfig()
+	cfg.enableCSRF = true
+	handler := newServerMux(cfg, gateway)
+
+	rr := serveV2TestRequest(t, handler, http.MethodPost, "/devices/device-1/wipe", "", nil)
+	require.Equal(t, http.StatusForbidden, rr.Code, rr.Body.String())
+
+	var rsp ReceivedHTTPResponse
+	err := json.NewDecoder(rr.Body).Decode(&rsp)
+	require.NoError(t, err)
+	require.Equal(t, &HTTPError{
+		Code:    http.StatusForbidden,
+		Message: ErrCSRFInvalid.Error(),
+		Type:    "forbidden",
+	}, rsp.Error)
+}
+
+func TestV2ReadResources(t *testing.T) {
+	gateway := &MockGatewayer{}
+	gateway.On("Available").Return(true)
+	gateway.On("GetFeatures").Return(testFeaturesMessage(t, testDeviceID, false), nil)
+
+	handler := newServerMux(defaultMuxConfig(), gateway)
+
+	// reading a resource does not read the device, it is known once the devices are listed
+	rr := serveV2TestRequest(t, handler, http.MethodGet, "/devices/device-1/backup/verify", "", nil)
+	require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+
+	rr = serveV2TestRequest(t, handler, http.MethodGet, "/devices", "", nil)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	rr = serveV2TestRequest(t, handler, http.MethodGet, "/devices/device-1/backup/verify", "", nil)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	require.JSONEq(t, `{"data":{"status":"none","words_entered":0}}`, rr.Body.String())
+
+	rr = serveV2TestRequest(t, handler, http.MethodGet, "/devices/device-1/addresses", "", nil)
+	require.Equal(t, http.StatusForbidden, rr.Code, rr.Body.String())
+	require.JSONEq(t, `{"error":{"code":403,"message":"address book is disabled","type":"forbidden"}}`, rr.Body.String())
+}
+
+func TestV2RoutesV1Routes(t *testing.T) {
+	mux := newServerMux(defaultMuxConfig(), &MockGatewayer{})
+
+	routes := make(map[string]bool)
+	for _, r := range v2Routes {
+		routes[r.v1Method+" "+r.v1Endpoint] = true
+	}
+
+	// every v1 route is served by a v2 resource, or excluded
+	all := map[string][]string{
+		"/api/v1/firmware_update": {http.MethodPut},
+		"/api/v1/available":       {http.MethodGet},
+	}
+	for e, verbs := range endpointsMethods {
+		all[e] = verbs
+	}
+
+	for e, verbs := range all {
+		endpoint := strings.TrimPrefix(e, "/api/v1")
+		req, err := http.NewRequest(http.MethodGet, e, nil)
+		require.NoError(t, err)
+		_, pattern := mux.Handler(req)
+		if _, ok := v2ExcludedEndpoints[strings.TrimPrefix(pattern, "/api/v1")]; ok {
+			continue
+		}
+
+		for _, verb := range verbs {
+			require.True(t, routes[verb+" "+endpoint], "%s %s has no v2 resource", verb, e)
+		}
+	}
+}
@@ -0,0 +1,284 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	"github.com/SkycoinProject/skycoin/src/cipher"
+	"github.com/gogo/protobuf/proto"
+
+	skyWallet "github.com/SkycoinProject/hardware-wallet-go/src/skywallet"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+)
+
+// Results of an address verification
+const (
+	verifyAddressMatched    = "matched"
+	verifyAddressMismatched = "mismatched"
+	verifyAddressRejected   = "rejected"
+)
+
+// VerifyAddressRequest is request data for /api/v1/verify_address
+type VerifyAddressRequest struct {
+	Index   int    `json:"index"`
+	Address string `json:"address"`
+}
+
+// VerifyAddressResponse is data returned by POST /api/v1/verify_address
+type VerifyAddressResponse struct {
+	Index uint32 `json:"index"`
+	// Expected is the address sent by the client
+	Expected string `json:"expected"`
+	// Address is the address derived by the device, empty if the user rejected it
+	Address string `json:"address,omitempty"`
+	// Result is one of matched, mismatched or rejected
+	Result string `json:"result"`
+}
+
+// verifyAddress derives an address on the device and shows it to the user.
+// If the device asks for a pin code or passphrase before deriving, the firmware
+// message is returned instead of a response. Anything other than a single address
+// equal to the expected one is not reported as matched.
+// The firmware response to the derivation is passed in derived when the verification
+// is resumed after the device asked for user input.
+func verifyAddress(gateway Gatewayer, index uint32, expected string, derived *wire.Message) (*VerifyAddressResponse, wire.Message, error) {
+	// derive the address without confirmation first, so that pin and passphrase
+	// prompts are answered before the device shows the address
+	var msg wire.Message
+	var err error
+	if derived != nil {
+		msg = *derived
+	} else {
+		msg, err = gateway.AddressGen(1, index, false)
+		if err != nil {
+			return nil, wire.Message{}, err
+		}
+	}
+
+	if msg.Kind != uint16(messages.MessageType_MessageType_ResponseSkycoinAddress) {
+		return nil, msg, nil
+	}
+
+	msg, err = gateway.AddressGen(1, index, true)
+	if err != nil {
+		return nil, wire.Message{}, err
+	}
+
+	// the address is shown on the device until the user confirms or rejects it
+	for msg.Kind == uint16(messages.MessageType_MessageType_ButtonRequest) {
+		msg, err = gateway.ButtonAck()
+		if err != nil {
+			return nil, wire.Message{}, err
+		}
+	}
+
+	resp := &VerifyAddressResponse{
+		Index:    index,
+		Expected: expected,
+	}
+
+	switch msg.Kind {
+	case uint16(messages.MessageType_MessageType_ResponseSkycoinAddress):
+		addresses, err := skyWallet.DecodeResponseSkycoinAddress(msg)
+		if err != nil {
+			return nil, wire.Message{}, err
+		}
+
+		if len(addresses) != 1 {
+			return nil, wire.Message{}, fmt.Errorf("device returned %d addresses, expected 1", len(addresses))
+		}
+
+		resp.Address = addresses[0]
+		resp.Result = verifyAddressMismatched
+		if resp.Address == expected {
+			resp.Result = verifyAddressMatched
+		}
+
+		return resp, msg, nil
+	case uint16(messages.MessageType_MessageType_Failure):
+		failure := &messages.Failure{}
+		if err := proto.Unmarshal(msg.Data, failure); err != nil {
+			return nil, wire.Message{}, err
+		}
+
+		if failure.GetCode() != messages.FailureType_Failure_ActionCancelled {
+			return nil, msg, nil
+		}
+
+		resp.Result = verifyAddressRejected
+		return resp, msg, nil
+	default:
+		// answering another prompt would finish the flow without the comparison
+		return nil, wire.Message{}, fmt.Errorf("received unexpected response message type: %s", messages.MessageType(msg.Kind))
+	}
+}
+
+// URI: /api/v1/verify_address
+// Method: POST
+// Args: JSON Body
+func verifyAddressHandler(gateway Gatewayer, addressBook *AddressBook, verifications *addressVerifications) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if r.Header.Get("Content-Type") != ContentTypeJSON {
+			resp := NewHTTPErrorResponse(http.StatusUnsupportedMediaType, "")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		var req VerifyAddressRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			resp := NewHTTPErrorResponse(http.StatusBadRequest, err.Error())
+			writeHTTPResponse(w, resp)
+			return
+		}
+		defer r.Body.Close()
+
+		if req.Index < 0 {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, "index cannot be negative")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if req.Address == "" {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, "address is required")
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		if _, err := cipher.DecodeBase58Address(req.Address); err != nil {
+			resp := NewHTTPErrorResponse(http.StatusUnprocessableEntity, fmt.Sprintf("invalid address: %v", err))
+			writeHTTPResponse(w, resp)
+			return
+		}
+
+		// for integration tests
+		if autoPressEmulatorButtons {
+			err := gateway.SetAutoPressButton(true, skyWallet.ButtonRight)
+			if err != nil {
+				logger.Error("verifyAddress failed: %s", err.Error())
+				resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+				writeHTTPResponse(w, resp)
+				return
+			}
+		}
+
+		verifications.respond(w, r, gateway, pendingVerification{
+			index:           uint32(req.Index),
+			expected:        req.Address,
+			addressBookDone: addressBook.addressGen(gateway, uint32(req.Index)),
+		}, nil)
+	}
+}
+
+// pendingVerification is an address verification waiting for a pin code or passphrase
+type pendingVerification struct {
+	index    uint32
+	expected string
+	// addressBookDone stores the verified address in the address book
+	addressBookDone func(Gatewayer, wire.Message)
+}
+
+// addressVerifications keeps an address verification pending while the device asks for
+// a pin code or passphrase, so that the address is shown and compared after the intermediate request
+type addressVerifications struct {
+	addressBook *AddressBook
+	lock        sync.Mutex
+	pending     *pendingVerification
+}
+
+func newAddressVerifications(addressBook *AddressBook) *addressVerifications {
+	return &addressVerifications{
+		addressBook: addressBook,
+	}
+}
+
+// interrupt stops tracking the pending verification, it was replaced on the device by a new flow
+func (v *addressVerifications) interrupt() {
+	v.lock.Lock()
+	v.pending = nil
+	v.lock.Unlock()
+}
+
+// failFlow stops tracking the pending verification
+func (v *addressVerifications) failFlow(err error) {
+	v.interrupt()
+}
+
+// resumeFlow shows the address derived for the pending verification and compares it with the expected address
+func (v *addressVerifications) resumeFlow(w http.ResponseWriter, r *http.Request, gateway Gatewayer, msg wire.Message) bool {
+	if isIntermediateMessage(msg) {
+		return false
+	}
+
+	v.lock.Lock()
+	pending := v.pending
+	v.pending = nil
+	v.lock.Unlock()
+
+	if pending == nil || msg.Kind != uint16(messages.MessageType_MessageType_ResponseSkycoinAddress) {
+		return false
+	}
+
+	v.respond(w, r, gateway, *pending, &msg)
+	return true
+}
+
+// respond runs the verification and writes its result.
+// If the device asks for a pin code or passphrase the verification is kept pending.
+func (v *addressVerifications) respond(w http.ResponseWriter, r *http.Request, gateway Gatewayer, verification pendingVerification, derived *wire.Message) {
+	var result *VerifyAddressResponse
+	var msg wire.Message
+	var err error
+	retCH := make(chan int, 1)
+	errCH := make(chan int, 1)
+	ctx := r.Context()
+
+	go func() {
+		result, msg, err = verifyAddress(gateway, verification.index, verification.expected, derived)
+		if err != nil {
+			errCH <- 1
+			return
+		}
+		retCH <- 1
+	}()
+
+	select {
+	case <-retCH:
+		if result == nil {
+			// the device asked for a pin code or passphrase, or failed
+			if isIntermediateMessage(msg) {
+				v.lock.Lock()
+				v.pending = &verification
+				v.lock.Unlock()
+			}
+			HandleFirmwareResponseMessages(w, msg)
+			return
+		}
+
+		v.addressBook.finish(gateway, verification.addressBookDone, msg)
+		writeHTTPResponse(w, HTTPResponse{
+			Data: result,
+		})
+	case <-errCH:
+		logger.Errorf("verifyAddress failed: %s", err.Error())
+		resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
+		writeHTTPResponse(w, resp)
+	case <-ctx.Done():
+		disConnErr := gateway.Disconnect()
+		if disConnErr != nil {
+			resp := NewHTTPErrorResponse(http.StatusInternalServerError, disConnErr.Error())
+			writeHTTPResponse(w, resp)
+		} else {
+			resp := NewHTTPErrorResponse(499, "Client Closed Request")
+			writeHTTPResponse(w, resp)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/SkycoinProject/hardware-wallet-go/src/skywallet/wire"
+	messages "github.com/SkycoinProject/hardware-wallet-protob/go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyAddress(t *testing.T) {
+	deviceAddress := "2EU3JbveHdkxW6z5tdhbbB2kRAWvXC2pLzw"
+	otherAddress := "zC8GAQGQBfwk7vtTxVoRG7iMperHNuyYPs"
+
+	notInitializedMsg := messages.Failure{
+		Code:    messages.FailureType_Failure_NotInitialized.Enum(),
+		Message: newStrPtr("Device not initialized"),
+	}
+
+	notInitializedBytes, err := notInitializedMsg.Marshal()
+	require.NoError(t, err)
+
+	pinMatrixRequestMessage := wire.Message{
+		Kind: uint16(messages.MessageType_MessageType_PinMatrixRequest),
+	}
+
+	cases := []struct {
+		name          string
+		method        string
+		status        int
+		contentType   string
+		httpBody      string
+		unlockResult  wire.Message
+		confirmResult wire.Message
+		buttonResult  wire.Message
+		httpResponse  HTTPResponse
+		result        *VerifyAddressResponse
+	}{
+		{
+			name:         "405",
+			method:       http.MethodGet,
+			status:       http.StatusMethodNotAllowed,
+			httpResponse: NewHTTPErrorResponse(http.StatusMethodNotAllowed, ""),
+		},
+
+		{
+			name:         "415 - Unsupported Media Type",
+			method:       http.MethodPost,
+			contentType:  ContentTypeForm,
+			status:       http.StatusUnsupportedMediaType,
+			httpResponse: NewHTTPErrorResponse(http.StatusUnsupportedMediaType, ""),
+		},
+
+		{
+			name:         "422 - negative index",
+			method:       http.MethodPost,
+			contentType:  ContentTypeJSON,
+			status:       http.StatusUnprocessableEntity,
+			httpBody:     toJSON(t, VerifyAddressRequest{Index: -1, Address: deviceAddress}),
+			httpResponse: NewHTTPErrorResponse(http.StatusUnprocessableEntity, "index cannot be negative"),
+		},
+
+		{
+			name:         "422 - no address",
+			method:       http.MethodPost,
+			contentType:  ContentTypeJSON,
+			status:       http.StatusUnprocessableEntity,
+			httpBody:     toJSON(t, VerifyAddressRequest{Index: 3}),
+			httpResponse: NewHTTPErrorResponse(http.StatusUnprocessableEntity, "address is required"),
+		},
+
+		{
+			name:         "422 - invalid address",
+			method:       http.MethodPost,
+			contentType:  ContentTypeJSON,
+			status:       http.StatusUnprocessableEntity,
+			httpBody:     toJSON(t, VerifyAddressRequest{Index: 3, Address: "foo"}),
+			httpResponse: NewHTTPErrorResponse(http.StatusUnprocessableEntity, "invalid address: Invalid address length"),
+		},
+
+		{
+			name:         "200 - PinMatrixRequest",
+			method:       http.MethodPost,
+			contentType:  ContentTypeJSON,
+			status:       http.StatusOK,
+			httpBody:     toJSON(t, VerifyAddressRequest{Index: 3, Address: deviceAddress}),
+			unlockResult: pinMatrixRequestMessage,
+			httpResponse: HTTPResponse{
+				Data: []string{"PinMatrixRequest"},
+			},
+		},
+
+		{
+			name:          "200 - matched",
+			method:        http.MethodPost,
+			contentType:   ContentTypeJSON,
+			status:        http.StatusOK,
+			httpBody:      toJSON(t, VerifyAddressRequest{Index: 3, Address: deviceAddress}),
+			unlockResult:  testAddressesMessage(t, deviceAddress),
+			confirmResult: buttonRequestMessage,
+			buttonResult:  testAddressesMessage(t, deviceAddress),
+			result: &VerifyAddressResponse{
+				Index:    3,
+				Expected: deviceAddress,
+				Address:  deviceAddress,
+				Result:   "matched",
+			},
+		},
+
+		{
+			name:          "200 - mismatched",
+			method:        http.MethodPost,
+			contentType:   ContentTypeJSON,
+			status:        http.StatusOK,
+			httpBody:      toJSON(t, VerifyAddressRequest{Index: 3, Address: otherAddress}),
+			unlockResult:  testAddressesMessage(t, deviceAddress),
+			confirmResult: buttonRequestMessage,
+			buttonResult:  testAddressesMessage(t, deviceAddress),
+			result: &VerifyAddressResponse{
+				Index:    3,
+				Expected: otherAddress,
+				Address:  deviceAddress,
+				Result:   "mismatched",
+			},
+		},
+
+		{
+			name:          "200 - rejected",
+			method:        http.MethodPost,
+			contentType:   ContentTypeJSON,
+			status:        http.StatusOK,
+			httpBody:      toJSON(t, VerifyAddressRequest{Index: 3, Address: deviceAddress}),
+			unlockResult:  testAddressesMessage(t, deviceAddress),
+			confirmResult: buttonRequestMessage,
+			buttonResult:  testFailureMessage(t, "Action cancelled by user"),
+			result: &VerifyAddressResponse{
+				Index:    3,
+				Expected: deviceAddress,
+				Result:   "rejected",
+			},
+		},
+
+		{
+			name:          "409 - Failure",
+			method:        http.MethodPost,
+			contentType:   ContentTypeJSON,
+			status:        http.StatusConflict,
+			httpBody:      toJSON(t, VerifyAddressRequest{Index: 3, Address: deviceAddress}),
+			unlockResult:  testAddressesMessage(t, deviceAddress),
+			confirmResult: wire.Message{Kind: uint16(messages.MessageType_MessageType_Failure), Data: notInitializedBytes},
+			httpResponse:  newTypedHTTPErrorResponse(http.StatusConflict, ErrorTypeNotInitialized, "Device not initialized"),
+		},
+
+		{
+			name:          "500 - unexpected prompt",
+			method:        http.MethodPost,
+			contentType:   ContentTypeJSON,
+			status:        http.StatusInternalServerError,
+			httpBody:      toJSON(t, VerifyAddressRequest{Index: 3, Address: deviceAddress}),
+			unlockResult:  testAddressesMessage(t, deviceAddress),
+			confirmResult: pinMatrixRequestMessage,
+			httpResponse:  NewHTTPErrorResponse(http.StatusInternalServerError, "received unexpected response message type: MessageType_PinMatrixRequest"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+			gateway.On("AddressGen", uint32(1), uint32(3), false).Return(tc.unlockResult, nil)
+			gateway.On("AddressGen", uint32(1), uint32(3), true).Return(tc.confirmResult, nil)
+			gateway.On("ButtonAck").Return(tc.buttonResult, nil)
+
+			handler := newServerMux(defaultMuxConfig(), gateway)
+
+			rr := serveTestRequest(t, handler, tc.method, "/verify_address", tc.httpBody, map[string]string{
+				"Content-Type": tc.contentType,
+			})
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+
+			var rsp ReceivedHTTPResponse
+			err := json.NewDecoder(rr.Body).Decode(&rsp)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.httpResponse.Error, rsp.Error)
+
+			if tc.result != nil {
+				var result VerifyAddressResponse
+				err = json.Unmarshal(rsp.Data, &result)
+				require.NoError(t, err)
+				require.Equal(t, *tc.result, result)
+			} else if tc.httpResponse.Data != nil {
+				require.JSONEq(t, toJSON(t, tc.httpResponse.Data), string(rsp.Data))
+			}
+		})
+	}
+}
+
+func TestVerifyAddressPinMatrix(t *testing.T) {
+	deviceAddress := "2EU3JbveHdkxW6z5tdhbbB2kRAWvXC2pLzw"
+	otherAddress := "zC8GAQGQBfwk7vtTxVoRG7iMperHNuyYPs"
+
+	cases := []struct {
+		name     string
+		expected string
+		result   VerifyAddressResponse
+	}{
+		{
+			name:     "matched",
+			expected: deviceAddress,
+			result: VerifyAddressResponse{
+				Index:    3,
+				Expected: deviceAddress,
+				Address:  deviceAddress,
+				Result:   "matched",
+			},
+		},
+
+		{
+			name:     "mismatched",
+			expected: otherAddress,
+			result: VerifyAddressResponse{
+				Index:    3,
+				Expected: otherAddress,
+				Address:  deviceAddress,
+				Result:   "mismatched",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &MockGatewayer{}
+			gateway.On("AddressGen", uint32(1), uint32(3), false).Return(testPinMatrixRequestMessage(t, messages.PinMatrixRequestType_PinMatrixRequestType_Current), nil)
+			gateway.On("PinMatrixAck", "1234").Return(testAddressesMessage(t, deviceAddress), nil)
+			gateway.On("AddressGen", uint32(1), uint32(3), true).Return(buttonRequestMessage, nil)
+			gateway.On("ButtonAck").Return(testAddressesMessage(t, deviceAddress), nil)
+
+			handler := newServerMux(defaultMuxConfig(), gateway)
+
+			rr := serveTestRequest(t, handler, http.MethodPost, "/verify_address", toJSON(t, VerifyAddressRequest{Index: 3, Address: tc.expected}), map[string]string{
+				"Content-Type": ContentTypeJSON,
+			})
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+			require.JSONEq(t, `{"data":["PinMatrixRequest"],"intermediate":{"kind":"pin_matrix","operation":"verify_address","pin_matrix_request_type":"current"}}`, rr.Body.String())
+
+			// the address is shown and compared once the pin code is entered
+			rr = serveTestRequest(t, handler, http.MethodPost, "/intermediate/pin_matrix", `{"pin": "1234"}`, map[string]string{
+				"Content-Type": ContentTypeJSON,
+			})
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+			var rsp ReceivedHTTPResponse
+			err := json.NewDecoder(rr.Body).Decode(&rsp)
+			require.NoError(t, err)
+
+			var result VerifyAddressResponse
+			err = json.Unmarshal(rsp.Data, &result)
+			require.NoError(t, err)
+			require.Equal(t, tc.result, result)
+
+			gateway.AssertCalled(t, "AddressGen", uint32(1), uint32(3), true)
+		})
+	}
+}
@@ -10,7 +10,7 @@ import (
 
 // URI: /api/v1/wipe
 // Method: DELETE
-func wipe(gateway Gatewayer) http.HandlerFunc {
+func wipe(gateway Gatewayer, auditLog *AuditLog, addressBook *AddressBook) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodDelete {
 			resp := NewHTTPErrorResponse(http.StatusMethodNotAllowed, "")
@@ -29,6 +29,10 @@ func wipe(gateway Gatewayer) http.HandlerFunc {
 			}
 		}
 
+		auditEntry := auditLog.begin(r, gateway, "wipe", nil)
+
+		addressBookDone := addressBook.reset(gateway)
+
 		var msg wire.Message
 		var err error
 		retCH := make(chan int)
@@ -46,12 +50,16 @@ func wipe(gateway Gatewayer) http.HandlerFunc {
 
 		select {
 		case <-retCH:
+			addressBook.finish(gateway, addressBookDone, msg)
+			auditLog.finish(auditEntry, msg)
 			HandleFirmwareResponseMessages(w, msg)
 		case <-errCH:
 			logger.Errorf("wipe failed: %s", err.Error())
+			auditLog.finishWithResult(auditEntry, auditResultError, err.Error())
 			resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
 			writeHTTPResponse(w, resp)
 		case <-ctx.Done():
+			auditLog.finishWithResult(auditEntry, auditResultClosed, "")
 			disConnErr := gateway.Disconnect()
 			if disConnErr != nil {
 				resp := NewHTTPErrorResponse(http.StatusInternalServerError, err.Error())
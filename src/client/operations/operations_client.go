@@ -6,6 +6,8 @@ package operations
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"io"
+
 	"github.com/go-openapi/runtime"
 
 	strfmt "github.com/go-openapi/strfmt"
@@ -53,6 +55,93 @@ func (a *Client) DeleteWipe(params *DeleteWipeParams, authInfo runtime.ClientAut
 
 }
 
+/*
+GetAddresses Returns the addresses already derived by the connected device.
+*/
+func (a *Client) GetAddresses(params *GetAddressesParams, authInfo runtime.ClientAuthInfoWriter) (*GetAddressesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetAddressesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetAddresses",
+		Method:             "GET",
+		PathPattern:        "/addresses",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{""},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetAddressesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetAddressesOK), nil
+
+}
+
+/*
+GetAddressesIndexQr Returns a QR code image of an address of the device, optionally as a payment URI.
+*/
+func (a *Client) GetAddressesIndexQr(params *GetAddressesIndexQrParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer) (*GetAddressesIndexQrOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetAddressesIndexQrParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetAddressesIndexQr",
+		Method:             "GET",
+		PathPattern:        "/addresses/{index}/qr",
+		ProducesMediaTypes: []string{"application/json", "image/png", "image/svg+xml"},
+		ConsumesMediaTypes: []string{""},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetAddressesIndexQrReader{formats: a.formats, writer: writer},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetAddressesIndexQrOK), nil
+
+}
+
+/*
+GetAudit Returns the audit log of sensitive device operations.
+*/
+func (a *Client) GetAudit(params *GetAuditParams, authInfo runtime.ClientAuthInfoWriter) (*GetAuditOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetAuditParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetAudit",
+		Method:             "GET",
+		PathPattern:        "/audit",
+		ProducesMediaTypes: []string{"application/json", "text/csv"},
+		ConsumesMediaTypes: []string{""},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetAuditReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetAuditOK), nil
+
+}
+
 /*
 GetAvailable check whether a skywallet is connected to the machine.
 */
@@ -82,6 +171,35 @@ func (a *Client) GetAvailable(params *GetAvailableParams, authInfo runtime.Clien
 
 }
 
+/*
+GetBackupVerify Returns the status of the last backup verification.
+*/
+func (a *Client) GetBackupVerify(params *GetBackupVerifyParams, authInfo runtime.ClientAuthInfoWriter) (*GetBackupVerifyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetBackupVerifyParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetBackupVerify",
+		Method:             "GET",
+		PathPattern:        "/backup/verify",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{""},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetBackupVerifyReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetBackupVerifyOK), nil
+
+}
+
 /*
 GetCsrf Returns csrf token
 */
@@ -110,6 +228,93 @@ func (a *Client) GetCsrf(params *GetCsrfParams) (*GetCsrfOK, error) {
 
 }
 
+/*
+GetEntropyMixed Downloads entropy of the device random number generator mixed with other entropy sources of the device.
+*/
+func (a *Client) GetEntropyMixed(params *GetEntropyMixedParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer) (*GetEntropyMixedOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetEntropyMixedParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetEntropyMixed",
+		Method:             "GET",
+		PathPattern:        "/entropy/mixed",
+		ProducesMediaTypes: []string{"application/json", "application/octet-stream"},
+		ConsumesMediaTypes: []string{""},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetEntropyMixedReader{formats: a.formats, writer: writer},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetEntropyMixedOK), nil
+
+}
+
+/*
+GetEntropyRaw Downloads raw entropy generated by the device random number generator.
+*/
+func (a *Client) GetEntropyRaw(params *GetEntropyRawParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer) (*GetEntropyRawOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetEntropyRawParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetEntropyRaw",
+		Method:             "GET",
+		PathPattern:        "/entropy/raw",
+		ProducesMediaTypes: []string{"application/json", "application/octet-stream"},
+		ConsumesMediaTypes: []string{""},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetEntropyRawReader{formats: a.formats, writer: writer},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetEntropyRawOK), nil
+
+}
+
+/*
+GetEntropyReport Downloads entropy generated by the device and returns a report of statistical tests run on it.
+*/
+func (a *Client) GetEntropyReport(params *GetEntropyReportParams, authInfo runtime.ClientAuthInfoWriter) (*GetEntropyReportOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetEntropyReportParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetEntropyReport",
+		Method:             "GET",
+		PathPattern:        "/entropy/report",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{""},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetEntropyReportReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetEntropyReportOK), nil
+
+}
+
 /*
 GetFeatures Returns device information.
 */
@@ -139,6 +344,93 @@ func (a *Client) GetFeatures(params *GetFeaturesParams, authInfo runtime.ClientA
 
 }
 
+/*
+GetMnemonicWords Returns the words of the bip39 english wordlist, to autocomplete recovery words.
+*/
+func (a *Client) GetMnemonicWords(params *GetMnemonicWordsParams, authInfo runtime.ClientAuthInfoWriter) (*GetMnemonicWordsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetMnemonicWordsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetMnemonicWords",
+		Method:             "GET",
+		PathPattern:        "/mnemonic/words",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{""},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetMnemonicWordsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetMnemonicWordsOK), nil
+
+}
+
+/*
+GetOpenapiJSON Returns this spec in JSON. Request bodies are validated against it.
+*/
+func (a *Client) GetOpenapiJSON(params *GetOpenapiJSONParams, authInfo runtime.ClientAuthInfoWriter) (*GetOpenapiJSONOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetOpenapiJSONParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetOpenapiJSON",
+		Method:             "GET",
+		PathPattern:        "/openapi.json",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{""},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetOpenapiJSONReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetOpenapiJSONOK), nil
+
+}
+
+/*
+GetProvision Returns the status of the last provisioning.
+*/
+func (a *Client) GetProvision(params *GetProvisionParams, authInfo runtime.ClientAuthInfoWriter) (*GetProvisionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetProvisionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetProvision",
+		Method:             "GET",
+		PathPattern:        "/provision",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{""},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetProvisionReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetProvisionOK), nil
+
+}
+
 /*
 GetVersion Returns daemon version information.
 */
@@ -168,6 +460,64 @@ func (a *Client) GetVersion(params *GetVersionParams, authInfo runtime.ClientAut
 
 }
 
+/*
+PostAccountDiscovery Finds the used addresses of the device wallet using a skycoin node. The discovery fails if it reaches the max-address-index of the daemon before finding gap_limit unused addresses in a row.
+*/
+func (a *Client) PostAccountDiscovery(params *PostAccountDiscoveryParams, authInfo runtime.ClientAuthInfoWriter) (*PostAccountDiscoveryOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostAccountDiscoveryParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PostAccountDiscovery",
+		Method:             "POST",
+		PathPattern:        "/account_discovery",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostAccountDiscoveryReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PostAccountDiscoveryOK), nil
+
+}
+
+/*
+PostAddressIndex Finds the index of an address of the connected device.
+*/
+func (a *Client) PostAddressIndex(params *PostAddressIndexParams, authInfo runtime.ClientAuthInfoWriter) (*PostAddressIndexOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostAddressIndexParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PostAddressIndex",
+		Method:             "POST",
+		PathPattern:        "/address_index",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostAddressIndexReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PostAddressIndexOK), nil
+
+}
+
 /*
 PostApplySettings Apply hardware wallet settings.
 */
@@ -226,6 +576,35 @@ func (a *Client) PostBackup(params *PostBackupParams, authInfo runtime.ClientAut
 
 }
 
+/*
+PostBackupVerify Starts a backup verification, a dry-run recovery which checks the seed backup matches the seed of the device.
+*/
+func (a *Client) PostBackupVerify(params *PostBackupVerifyParams, authInfo runtime.ClientAuthInfoWriter) (*PostBackupVerifyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostBackupVerifyParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PostBackupVerify",
+		Method:             "POST",
+		PathPattern:        "/backup/verify",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostBackupVerifyReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PostBackupVerifyOK), nil
+
+}
+
 /*
 PostCheckMessageSignature Check a message signature matches the given address.
 */
@@ -255,6 +634,35 @@ func (a *Client) PostCheckMessageSignature(params *PostCheckMessageSignaturePara
 
 }
 
+/*
+PostCheckMessageSignatureArmored Check a signed message in the armored text format matches its address.
+*/
+func (a *Client) PostCheckMessageSignatureArmored(params *PostCheckMessageSignatureArmoredParams, authInfo runtime.ClientAuthInfoWriter) (*PostCheckMessageSignatureArmoredOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostCheckMessageSignatureArmoredParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PostCheckMessageSignatureArmored",
+		Method:             "POST",
+		PathPattern:        "/check_message_signature/armored",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostCheckMessageSignatureArmoredReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PostCheckMessageSignatureArmoredOK), nil
+
+}
+
 /*
 PostConfigurePinCode Configure a pin code on the device.
 */
@@ -285,7 +693,36 @@ func (a *Client) PostConfigurePinCode(params *PostConfigurePinCodeParams, authIn
 }
 
 /*
-PostGenerateAddresses Generate addresses for the hardware wallet seed.
+PostEmulatorLoadDevice Loads a mnemonic, pin code, label and passphrase protection in the emulator without confirmations. Only available in emulator mode.
+*/
+func (a *Client) PostEmulatorLoadDevice(params *PostEmulatorLoadDeviceParams, authInfo runtime.ClientAuthInfoWriter) (*PostEmulatorLoadDeviceOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostEmulatorLoadDeviceParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PostEmulatorLoadDevice",
+		Method:             "POST",
+		PathPattern:        "/emulator/load_device",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostEmulatorLoadDeviceReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PostEmulatorLoadDeviceOK), nil
+
+}
+
+/*
+PostGenerateAddresses Generate addresses for the hardware wallet seed. Requests with the application/x-ndjson Accept header stream an AddressBatch line per batch of 99 addresses.
 */
 func (a *Client) PostGenerateAddresses(params *PostGenerateAddressesParams, authInfo runtime.ClientAuthInfoWriter) (*PostGenerateAddressesOK, error) {
 	// TODO: Validate the params before sending
@@ -297,7 +734,7 @@ func (a *Client) PostGenerateAddresses(params *PostGenerateAddressesParams, auth
 		ID:                 "PostGenerateAddresses",
 		Method:             "POST",
 		PathPattern:        "/generate_addresses",
-		ProducesMediaTypes: []string{"application/json"},
+		ProducesMediaTypes: []string{"application/json", "application/x-ndjson"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
@@ -400,6 +837,35 @@ func (a *Client) PostIntermediatePassphrase(params *PostIntermediatePassphrasePa
 
 }
 
+/*
+PostIntermediatePassphraseState passphrase state ack request.
+*/
+func (a *Client) PostIntermediatePassphraseState(params *PostIntermediatePassphraseStateParams, authInfo runtime.ClientAuthInfoWriter) (*PostIntermediatePassphraseStateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostIntermediatePassphraseStateParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PostIntermediatePassphraseState",
+		Method:             "POST",
+		PathPattern:        "/intermediate/passphrase_state",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{""},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostIntermediatePassphraseStateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PostIntermediatePassphraseStateOK), nil
+
+}
+
 /*
 PostIntermediatePinMatrix pin matrix ack request.
 */
@@ -458,6 +924,128 @@ func (a *Client) PostIntermediateWord(params *PostIntermediateWordParams, authIn
 
 }
 
+/*
+PostMnemonicValidate Validate a mnemonic against the bip39 english wordlist and checksum, without the device.
+*/
+func (a *Client) PostMnemonicValidate(params *PostMnemonicValidateParams, authInfo runtime.ClientAuthInfoWriter) (*PostMnemonicValidateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostMnemonicValidateParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PostMnemonicValidate",
+		Method:             "POST",
+		PathPattern:        "/mnemonic/validate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostMnemonicValidateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PostMnemonicValidateOK), nil
+
+}
+
+/*
+PostProvision Provisions the device from a profile, wiping it and running the steps the profile requires.
+*/
+func (a *Client) PostProvision(params *PostProvisionParams, authInfo runtime.ClientAuthInfoWriter) (*PostProvisionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostProvisionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PostProvision",
+		Method:             "POST",
+		PathPattern:        "/provision",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostProvisionReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PostProvisionOK), nil
+
+}
+
+/*
+PostProvisionResume Resumes a failed or interrupted provisioning from the step which did not complete.
+*/
+func (a *Client) PostProvisionResume(params *PostProvisionResumeParams, authInfo runtime.ClientAuthInfoWriter) (*PostProvisionResumeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostProvisionResumeParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PostProvisionResume",
+		Method:             "POST",
+		PathPattern:        "/provision/resume",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{""},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostProvisionResumeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PostProvisionResumeOK), nil
+
+}
+
+/*
+PostRPC Serves the device operations as JSON-RPC 2.0 methods, for a request or a batch of requests. The params of a method are the body of its endpoint, or its query args for a GET endpoint. JSON-RPC errors are sent with a 200 status. The firmware update, the entropy download and the address QR codes send binary data, they are not served as methods. Neither are the endpoints which do not use the device, the mnemonic validation and words, audit, version and OpenAPI spec.
+*/
+func (a *Client) PostRPC(params *PostRPCParams, authInfo runtime.ClientAuthInfoWriter) (*PostRPCOK, *PostRPCNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostRPCParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PostRPC",
+		Method:             "POST",
+		PathPattern:        "/rpc",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostRPCReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	switch value := result.(type) {
+	case *PostRPCOK:
+		return value, nil, nil
+	case *PostRPCNoContent:
+		return nil, value, nil
+	}
+	return nil, nil, nil
+
+}
+
 /*
 PostRecovery Recover existing wallet using seed.
 */
@@ -545,6 +1133,35 @@ func (a *Client) PostSignMessage(params *PostSignMessageParams, authInfo runtime
 
 }
 
+/*
+PostSignMessages Sign several messages in one device session, each one confirmed on the device.
+*/
+func (a *Client) PostSignMessages(params *PostSignMessagesParams, authInfo runtime.ClientAuthInfoWriter) (*PostSignMessagesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostSignMessagesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PostSignMessages",
+		Method:             "POST",
+		PathPattern:        "/sign_messages",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostSignMessagesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PostSignMessagesOK), nil
+
+}
+
 /*
 PostTransactionSign Sign a transaction with the hardware wallet.
 */
@@ -574,6 +1191,35 @@ func (a *Client) PostTransactionSign(params *PostTransactionSignParams, authInfo
 
 }
 
+/*
+PostVerifyAddress Shows an address on the device and compares it with the expected address.
+*/
+func (a *Client) PostVerifyAddress(params *PostVerifyAddressParams, authInfo runtime.ClientAuthInfoWriter) (*PostVerifyAddressOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostVerifyAddressParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PostVerifyAddress",
+		Method:             "POST",
+		PathPattern:        "/verify_address",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostVerifyAddressReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PostVerifyAddressOK), nil
+
+}
+
 /*
 PutCancel Cancels the current operation.
 */
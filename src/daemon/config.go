@@ -3,7 +3,10 @@ package daemon
 import (
 	"errors"
 	"flag"
+	"fmt"
 	"log"
+	"math"
+	"net/url"
 	"os"
 	"strings"
 
@@ -40,6 +43,11 @@ type AppConfig struct {
 	HostWhitelist string
 	hostWhitelist []string
 
+	// Serve the gRPC service of hardware_wallet.proto
+	EnableGRPC bool
+	// Address to serve the gRPC service on
+	GRPCAddr string
+
 	// Logging
 	ColorLog bool
 	// This is the value registered with flag, it is converted to LogLevel after parsing
@@ -59,6 +67,16 @@ type AppConfig struct {
 	// Data directory holds app data -- defaults to ~/.skycoin
 	DataDirectory string
 
+	// Skycoin node REST API address used for account discovery, e.g. http://127.0.0.1:6420
+	NodeAddress string
+
+	// Highest address index which can be generated
+	MaxAddressIndex uint
+	// Number of addresses scanned to find the index of an address
+	AddressLookupLimit uint
+	// Maximum number of entropy bytes which can be downloaded at once
+	MaxEntropyBytes uint
+
 	// DaemonMode decides with what api is enabled, either wallet or emulator
 	DaemonMode string
 	daemonMode skyWallet.DeviceType
@@ -78,6 +96,10 @@ func NewAppConfig(port int, datadir string) AppConfig {
 		// disable csrf by default
 		EnableCSRF: false,
 
+		// gRPC service
+		EnableGRPC: false,
+		GRPCAddr:   "127.0.0.1:9511",
+
 		// Enable cpu profiling
 		ProfileCPU: false,
 		// Where the file is written to
@@ -90,6 +112,10 @@ func NewAppConfig(port int, datadir string) AppConfig {
 		DaemonMode: skyWallet.DeviceTypeUSB.String(),
 
 		DataDirectory: datadir,
+
+		MaxAddressIndex:    api.DefaultMaxAddressIndex,
+		AddressLookupLimit: api.DefaultAddressLookupLimit,
+		MaxEntropyBytes:    api.DefaultMaxEntropyBytes,
 	}
 }
 
@@ -111,6 +137,25 @@ func (c *Config) postProcess() error {
 		c.App.hostWhitelist = strings.Split(c.App.HostWhitelist, ",")
 	}
 
+	if c.App.NodeAddress != "" {
+		u, err := url.Parse(c.App.NodeAddress)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.New("node address should be an http or https URL")
+		}
+	}
+
+	if c.App.MaxAddressIndex == 0 || c.App.MaxAddressIndex > math.MaxUint32 {
+		return fmt.Errorf("max address index should be between 1 and %d", uint32(math.MaxUint32))
+	}
+
+	if c.App.AddressLookupLimit == 0 || c.App.AddressLookupLimit > c.App.MaxAddressIndex+1 {
+		return errors.New("address lookup limit should be between 1 and max address index + 1")
+	}
+
+	if c.App.MaxEntropyBytes == 0 || c.App.MaxEntropyBytes > math.MaxUint32 {
+		return fmt.Errorf("max entropy bytes should be between 1 and %d", uint32(math.MaxUint32))
+	}
+
 	c.App.daemonMode = skyWallet.DeviceTypeFromString(c.App.DaemonMode)
 	if c.App.daemonMode == skyWallet.DeviceTypeInvalid {
 		return errors.New("invalid device type")
@@ -128,6 +173,9 @@ func (c *AppConfig) RegisterFlags() {
 	flag.BoolVar(&c.DisableHeaderCheck, "disable-header-check", c.DisableHeaderCheck, "disables the host, origin and referer header checks.")
 	flag.StringVar(&c.HostWhitelist, "host-whitelist", c.HostWhitelist, "Hostnames to whitelist in the Host header check. Only applies when the web interface is bound to localhost.")
 
+	flag.BoolVar(&c.EnableGRPC, "enable-grpc", c.EnableGRPC, "serve the gRPC service of hardware_wallet.proto")
+	flag.StringVar(&c.GRPCAddr, "grpc-addr", c.GRPCAddr, "addr to serve the gRPC service on")
+
 	flag.BoolVar(&c.ColorLog, "color-log", c.ColorLog, "Add terminal colors to log output")
 	flag.StringVar(&c.LogLevel, "log-level", c.LogLevel, "Choices are: debug, info, warn, error, fatal, panic")
 	flag.BoolVar(&c.LogToFile, "logtofile", c.LogToFile, "log to file")
@@ -139,6 +187,12 @@ func (c *AppConfig) RegisterFlags() {
 
 	flag.StringVar(&c.DataDirectory, "data-dir", c.DataDirectory, "directory to store app data (defaults to ~/.skycoin)")
 
+	flag.StringVar(&c.NodeAddress, "node-address", c.NodeAddress, "skycoin node REST API address used for account discovery, e.g. http://127.0.0.1:6420. Account discovery is disabled if empty")
+
+	flag.UintVar(&c.MaxAddressIndex, "max-address-index", c.MaxAddressIndex, "highest address index which can be generated")
+	flag.UintVar(&c.AddressLookupLimit, "address-lookup-limit", c.AddressLookupLimit, "number of addresses scanned to find the index of an address")
+	flag.UintVar(&c.MaxEntropyBytes, "max-entropy-bytes", c.MaxEntropyBytes, "maximum number of entropy bytes which can be downloaded at once")
+
 	flag.StringVar(&c.DaemonMode, "daemon-mode", c.DaemonMode, "Choices are: USB or EMULATOR")
 }
 
@@ -33,6 +33,7 @@ func NewDaemon(config Config, logger *logging.Logger) *Daemon {
 // Run starts the daemon
 func (d *Daemon) Run() error {
 	var apiServer *api.Server
+	var grpcServer *api.GRPCServer
 	var retErr error
 	errC := make(chan error, 10)
 
@@ -102,6 +103,18 @@ func (d *Daemon) Run() error {
 		goto earlyShutdown
 	}
 
+	// the gRPC service shares the device gateway of the api server
+	if d.config.App.EnableGRPC {
+		grpcServer, err = api.CreateGRPC(d.config.App.GRPCAddr, apiServer)
+		if err != nil {
+			d.logger.Errorf("Failed to start gRPC interface: %v", err)
+			retErr = err
+			goto earlyShutdown
+		}
+
+		d.logger.Infof("gRPC interface listening on %s", grpcServer.Addr())
+	}
+
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
@@ -112,6 +125,18 @@ func (d *Daemon) Run() error {
 		}
 	}()
 
+	if grpcServer != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := grpcServer.Serve(); err != nil {
+				d.logger.Error(err)
+				errC <- err
+			}
+		}()
+	}
+
 	select {
 	case <-quit:
 	case retErr = <-errC:
@@ -120,6 +145,11 @@ func (d *Daemon) Run() error {
 
 	d.logger.Info("Shutting down...")
 
+	if grpcServer != nil {
+		d.logger.Info("Closing gRPC server")
+		grpcServer.Shutdown()
+	}
+
 	if apiServer != nil {
 		d.logger.Info("Closing api server")
 		apiServer.Shutdown()
@@ -178,6 +208,11 @@ func (d *Daemon) createServer(host string, gateway *api.Gateway) (*api.Server, e
 		HostWhitelist:      d.config.App.hostWhitelist,
 		Mode:               d.config.App.daemonMode,
 		Build:              d.config.Build,
+		DataDirectory:      d.config.App.DataDirectory,
+		NodeAddress:        d.config.App.NodeAddress,
+		MaxAddressIndex:    uint32(d.config.App.MaxAddressIndex),
+		AddressLookupLimit: uint32(d.config.App.AddressLookupLimit),
+		MaxEntropyBytes:    uint32(d.config.App.MaxEntropyBytes),
 	}
 
 	var s *api.Server
@@ -8,9 +8,7 @@ package models
 import (
 	strfmt "github.com/go-openapi/strfmt"
 
-	"github.com/go-openapi/errors"
 	"github.com/go-openapi/swag"
-	"github.com/go-openapi/validate"
 )
 
 // ApplySettingsRequest apply settings request
@@ -23,31 +21,12 @@ type ApplySettingsRequest struct {
 	// language
 	Language string `json:"language,omitempty"`
 
-	// use passphrase
-	// Required: true
-	UsePassphrase *bool `json:"use_passphrase"`
+	// not changed if null
+	UsePassphrase *bool `json:"use_passphrase,omitempty"`
 }
 
 // Validate validates this apply settings request
 func (m *ApplySettingsRequest) Validate(formats strfmt.Registry) error {
-	var res []error
-
-	if err := m.validateUsePassphrase(formats); err != nil {
-		res = append(res, err)
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
-
-func (m *ApplySettingsRequest) validateUsePassphrase(formats strfmt.Registry) error {
-
-	if err := validate.Required("use_passphrase", "body", m.UsePassphrase); err != nil {
-		return err
-	}
-
 	return nil
 }
 
@@ -25,6 +25,9 @@ type CheckMessageSignatureRequest struct {
 	// Required: true
 	Message *string `json:"message"`
 
+	// check the signature in the daemon, without the device
+	Offline bool `json:"offline,omitempty"`
+
 	// signature
 	// Required: true
 	Signature *string `json:"signature"`
@@ -17,6 +17,24 @@ import (
 // swagger:model GenerateMnemonicRequest
 type GenerateMnemonicRequest struct {
 
+	// display random
+	DisplayRandom bool `json:"display_random,omitempty"`
+
+	// label
+	Label string `json:"label,omitempty"`
+
+	// language
+	Language string `json:"language,omitempty"`
+
+	// pin protection
+	PinProtection bool `json:"pin_protection,omitempty"`
+
+	// skip backup
+	SkipBackup bool `json:"skip_backup,omitempty"`
+
+	// entropy of the mnemonic in bits, 128 for 12 words and 256 for 24 words
+	Strength int64 `json:"strength,omitempty"`
+
 	// use passphrase
 	UsePassphrase bool `json:"use_passphrase,omitempty"`
 
@@ -6,10 +6,13 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
+
 	strfmt "github.com/go-openapi/strfmt"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // HTTPErrorResponse HTTP error response
@@ -79,10 +82,114 @@ type HTTPErrorResponseError struct {
 
 	// message
 	Message string `json:"message,omitempty"`
+
+	// machine readable type of the error, mapped from the FailureType of firmware Failure messages. It is invalid_request when the request body does not match this spec. It is set for some other errors only.
+	// Enum: [unexpected_message button_expected data_error action_cancelled pin_expected pin_cancelled pin_invalid invalid_signature process_error not_enough_funds not_initialized pin_mismatch address_generation firmware_panic firmware_error failure unsupported_firmware_request invalid_request]
+	Type string `json:"type,omitempty"`
 }
 
 // Validate validates this HTTP error response error
 func (m *HTTPErrorResponseError) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var httpErrorResponseErrorTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["unexpected_message","button_expected","data_error","action_cancelled","pin_expected","pin_cancelled","pin_invalid","invalid_signature","process_error","not_enough_funds","not_initialized","pin_mismatch","address_generation","firmware_panic","firmware_error","failure","unsupported_firmware_request","invalid_request"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		httpErrorResponseErrorTypeTypePropEnum = append(httpErrorResponseErrorTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// HTTPErrorResponseErrorTypeUnexpectedMessage captures enum value "unexpected_message"
+	HTTPErrorResponseErrorTypeUnexpectedMessage string = "unexpected_message"
+
+	// HTTPErrorResponseErrorTypeButtonExpected captures enum value "button_expected"
+	HTTPErrorResponseErrorTypeButtonExpected string = "button_expected"
+
+	// HTTPErrorResponseErrorTypeDataError captures enum value "data_error"
+	HTTPErrorResponseErrorTypeDataError string = "data_error"
+
+	// HTTPErrorResponseErrorTypeActionCancelled captures enum value "action_cancelled"
+	HTTPErrorResponseErrorTypeActionCancelled string = "action_cancelled"
+
+	// HTTPErrorResponseErrorTypePinExpected captures enum value "pin_expected"
+	HTTPErrorResponseErrorTypePinExpected string = "pin_expected"
+
+	// HTTPErrorResponseErrorTypePinCancelled captures enum value "pin_cancelled"
+	HTTPErrorResponseErrorTypePinCancelled string = "pin_cancelled"
+
+	// HTTPErrorResponseErrorTypePinInvalid captures enum value "pin_invalid"
+	HTTPErrorResponseErrorTypePinInvalid string = "pin_invalid"
+
+	// HTTPErrorResponseErrorTypeInvalidSignature captures enum value "invalid_signature"
+	HTTPErrorResponseErrorTypeInvalidSignature string = "invalid_signature"
+
+	// HTTPErrorResponseErrorTypeProcessError captures enum value "process_error"
+	HTTPErrorResponseErrorTypeProcessError string = "process_error"
+
+	// HTTPErrorResponseErrorTypeNotEnoughFunds captures enum value "not_enough_funds"
+	HTTPErrorResponseErrorTypeNotEnoughFunds string = "not_enough_funds"
+
+	// HTTPErrorResponseErrorTypeNotInitialized captures enum value "not_initialized"
+	HTTPErrorResponseErrorTypeNotInitialized string = "not_initialized"
+
+	// HTTPErrorResponseErrorTypePinMismatch captures enum value "pin_mismatch"
+	HTTPErrorResponseErrorTypePinMismatch string = "pin_mismatch"
+
+	// HTTPErrorResponseErrorTypeAddressGeneration captures enum value "address_generation"
+	HTTPErrorResponseErrorTypeAddressGeneration string = "address_generation"
+
+	// HTTPErrorResponseErrorTypeFirmwarePanic captures enum value "firmware_panic"
+	HTTPErrorResponseErrorTypeFirmwarePanic string = "firmware_panic"
+
+	// HTTPErrorResponseErrorTypeFirmwareError captures enum value "firmware_error"
+	HTTPErrorResponseErrorTypeFirmwareError string = "firmware_error"
+
+	// HTTPErrorResponseErrorTypeFailure captures enum value "failure"
+	HTTPErrorResponseErrorTypeFailure string = "failure"
+
+	// HTTPErrorResponseErrorTypeUnsupportedFirmwareRequest captures enum value "unsupported_firmware_request"
+	HTTPErrorResponseErrorTypeUnsupportedFirmwareRequest string = "unsupported_firmware_request"
+
+	// HTTPErrorResponseErrorTypeInvalidRequest captures enum value "invalid_request"
+	HTTPErrorResponseErrorTypeInvalidRequest string = "invalid_request"
+)
+
+// prop value enum
+func (m *HTTPErrorResponseError) validateTypeEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, httpErrorResponseErrorTypeTypePropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *HTTPErrorResponseError) validateType(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("error"+"."+"type", "body", m.Type); err != nil {
+		return err
+	}
+
 	return nil
 }
 
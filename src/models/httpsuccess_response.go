@@ -8,6 +8,7 @@ package models
 import (
 	strfmt "github.com/go-openapi/strfmt"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/swag"
 )
 
@@ -17,10 +18,40 @@ type HttpsuccessResponse struct {
 
 	// data
 	Data []string `json:"data"`
+
+	// intermediate
+	Intermediate *HttpsuccessResponseIntermediate `json:"intermediate,omitempty"`
 }
 
 // Validate validates this httpsuccess response
 func (m *HttpsuccessResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateIntermediate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HttpsuccessResponse) validateIntermediate(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Intermediate) { // not required
+		return nil
+	}
+
+	if m.Intermediate != nil {
+		if err := m.Intermediate.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("intermediate")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -41,3 +72,43 @@ func (m *HttpsuccessResponse) UnmarshalBinary(b []byte) error {
 	*m = res
 	return nil
 }
+
+// HttpsuccessResponseIntermediate set when the device is waiting for user input, data has the message type of the request
+// swagger:model HttpsuccessResponseIntermediate
+type HttpsuccessResponseIntermediate struct {
+
+	// what the user confirms on the device, for a button request
+	ButtonRequestType string `json:"button_request_type,omitempty"`
+
+	// the intermediate endpoint which answers the request: button, pin_matrix, passphrase, word or passphrase_state
+	Kind string `json:"kind,omitempty"`
+
+	// endpoint, relative to /api/v1, of the flow the device is running
+	Operation string `json:"operation,omitempty"`
+
+	// the pin code the user enters, for a pin matrix request: current, new or confirm
+	PinMatrixRequestType string `json:"pin_matrix_request_type,omitempty"`
+}
+
+// Validate validates this httpsuccess response intermediate
+func (m *HttpsuccessResponseIntermediate) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HttpsuccessResponseIntermediate) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HttpsuccessResponseIntermediate) UnmarshalBinary(b []byte) error {
+	var res HttpsuccessResponseIntermediate
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
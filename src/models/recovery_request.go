@@ -21,8 +21,7 @@ type RecoveryRequest struct {
 	DryRun bool `json:"dry_run,omitempty"`
 
 	// use passphrase
-	// Required: true
-	UsePassphrase *bool `json:"use_passphrase"`
+	UsePassphrase *bool `json:"use_passphrase,omitempty"`
 
 	// word count
 	// Required: true
@@ -33,10 +32,6 @@ type RecoveryRequest struct {
 func (m *RecoveryRequest) Validate(formats strfmt.Registry) error {
 	var res []error
 
-	if err := m.validateUsePassphrase(formats); err != nil {
-		res = append(res, err)
-	}
-
 	if err := m.validateWordCount(formats); err != nil {
 		res = append(res, err)
 	}
@@ -47,15 +42,6 @@ func (m *RecoveryRequest) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *RecoveryRequest) validateUsePassphrase(formats strfmt.Registry) error {
-
-	if err := validate.Required("use_passphrase", "body", m.UsePassphrase); err != nil {
-		return err
-	}
-
-	return nil
-}
-
 func (m *RecoveryRequest) validateWordCount(formats strfmt.Registry) error {
 
 	if err := validate.Required("word_count", "body", m.WordCount); err != nil {
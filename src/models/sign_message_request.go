@@ -17,9 +17,14 @@ import (
 // swagger:model SignMessageRequest
 type SignMessageRequest struct {
 
+	// address used instead of address_n, its index is found on the device. One of address_n and address is required
+	Address string `json:"address,omitempty"`
+
 	// address n
-	// Required: true
-	AddressN *int64 `json:"address_n"`
+	AddressN *int64 `json:"address_n,omitempty"`
+
+	// also return the signed message in the armored text format
+	Armored bool `json:"armored,omitempty"`
 
 	// message
 	// Required: true
@@ -30,10 +35,6 @@ type SignMessageRequest struct {
 func (m *SignMessageRequest) Validate(formats strfmt.Registry) error {
 	var res []error
 
-	if err := m.validateAddressN(formats); err != nil {
-		res = append(res, err)
-	}
-
 	if err := m.validateMessage(formats); err != nil {
 		res = append(res, err)
 	}
@@ -44,15 +45,6 @@ func (m *SignMessageRequest) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *SignMessageRequest) validateAddressN(formats strfmt.Registry) error {
-
-	if err := validate.Required("address_n", "body", m.AddressN); err != nil {
-		return err
-	}
-
-	return nil
-}
-
 func (m *SignMessageRequest) validateMessage(formats strfmt.Registry) error {
 
 	if err := validate.Required("message", "body", m.Message); err != nil {
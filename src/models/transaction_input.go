@@ -17,13 +17,15 @@ import (
 // swagger:model TransactionInput
 type TransactionInput struct {
 
+	// address used instead of index, its index is found on the device. One of index and address is required
+	Address string `json:"address,omitempty"`
+
 	// hash
 	// Required: true
 	Hash *string `json:"hash"`
 
 	// index
-	// Required: true
-	Index *int64 `json:"index"`
+	Index *int64 `json:"index,omitempty"`
 }
 
 // Validate validates this transaction input
@@ -34,10 +36,6 @@ func (m *TransactionInput) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
-	if err := m.validateIndex(formats); err != nil {
-		res = append(res, err)
-	}
-
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -53,15 +51,6 @@ func (m *TransactionInput) validateHash(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *TransactionInput) validateIndex(formats strfmt.Registry) error {
-
-	if err := validate.Required("index", "body", m.Index); err != nil {
-		return err
-	}
-
-	return nil
-}
-
 // MarshalBinary interface implementation
 func (m *TransactionInput) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -22,8 +22,10 @@ type TransactionOutput struct {
 	Address *string `json:"address"`
 
 	// address index
-	// Required: true
-	AddressIndex *int64 `json:"address_index"`
+	AddressIndex *int64 `json:"address_index,omitempty"`
+
+	// if address_index is not set, it is found from the address on the device
+	Change bool `json:"change,omitempty"`
 
 	// coins
 	// Required: true
@@ -42,10 +44,6 @@ func (m *TransactionOutput) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
-	if err := m.validateAddressIndex(formats); err != nil {
-		res = append(res, err)
-	}
-
 	if err := m.validateCoins(formats); err != nil {
 		res = append(res, err)
 	}
@@ -69,15 +67,6 @@ func (m *TransactionOutput) validateAddress(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *TransactionOutput) validateAddressIndex(formats strfmt.Registry) error {
-
-	if err := validate.Required("address_index", "body", m.AddressIndex); err != nil {
-		return err
-	}
-
-	return nil
-}
-
 func (m *TransactionOutput) validateCoins(formats strfmt.Registry) error {
 
 	if err := validate.Required("coins", "body", m.Coins); err != nil {
@@ -0,0 +1,568 @@
+/*
+Package qrcode encodes data in QR code symbols.
+
+Only what is needed to encode payment URIs is implemented: data is encoded in byte mode
+with error correction level M, in the smallest symbol version from 1 to 10 which fits it.
+*/
+package qrcode
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+)
+
+const (
+	// MaxDataLength is the maximum number of bytes which can be encoded
+	MaxDataLength = 213
+
+	// QuietZone is the width in modules of the light border around a symbol
+	QuietZone = 4
+
+	maxVersion = 10
+)
+
+var (
+	// ErrDataTooLong is returned when the data does not fit in a symbol
+	ErrDataTooLong = fmt.Errorf("data is longer than %d bytes", MaxDataLength)
+	// ErrEmptyData is returned when there is no data to encode
+	ErrEmptyData = errors.New("data is empty")
+)
+
+// blockLayout describes the error correction blocks of a symbol version at error correction level M
+type blockLayout struct {
+	ecPerBlock int
+	// data codewords of each block
+	blocks []int
+}
+
+func (l blockLayout) dataCodewords() int {
+	n := 0
+	for _, b := range l.blocks {
+		n += b
+	}
+	return n
+}
+
+// layouts of versions 1 to 10 at error correction level M, indexed by version
+var layouts = [maxVersion + 1]blockLayout{
+	1:  {10, []int{16}},
+	2:  {16, []int{28}},
+	3:  {26, []int{44}},
+	4:  {18, []int{32, 32}},
+	5:  {24, []int{43, 43}},
+	6:  {16, []int{27, 27, 27, 27}},
+	7:  {18, []int{31, 31, 31, 31}},
+	8:  {22, []int{38, 38, 39, 39}},
+	9:  {22, []int{36, 36, 36, 37, 37}},
+	10: {26, []int{43, 43, 43, 43, 44}},
+}
+
+// alignment pattern center coordinates, indexed by version
+var alignmentPositions = [maxVersion + 1][]int{
+	2:  {6, 18},
+	3:  {6, 22},
+	4:  {6, 26},
+	5:  {6, 30},
+	6:  {6, 34},
+	7:  {6, 22, 38},
+	8:  {6, 24, 42},
+	9:  {6, 26, 46},
+	10: {6, 28, 50},
+}
+
+// QRCode is an encoded QR code symbol
+type QRCode struct {
+	version int
+	size    int
+	// modules are indexed by row then column, true is dark
+	modules [][]bool
+	// function marks modules which are not data modules
+	function [][]bool
+}
+
+// Encode encodes data in a QR code symbol
+func Encode(data []byte) (*QRCode, error) {
+	if len(data) == 0 {
+		return nil, ErrEmptyData
+	}
+
+	version := 0
+	for v := 1; v <= maxVersion; v++ {
+		if 4+countBits(v)+8*len(data) <= layouts[v].dataCodewords()*8 {
+			version = v
+			break
+		}
+	}
+
+	if version == 0 {
+		return nil, ErrDataTooLong
+	}
+
+	size := 17 + 4*version
+	q := &QRCode{
+		version:  version,
+		size:     size,
+		modules:  newGrid(size),
+		function: newGrid(size),
+	}
+
+	q.drawFunctionPatterns()
+	q.drawCodewords(interleave(layouts[version], encodeData(data, version)))
+
+	bestMask := 0
+	bestPenalty := -1
+	for mask := 0; mask < 8; mask++ {
+		q.applyMask(mask)
+		q.drawFormatBits(mask)
+		if p := q.penalty(); bestPenalty < 0 || p < bestPenalty {
+			bestMask = mask
+			bestPenalty = p
+		}
+		// masks are XORed, so applying it again removes it
+		q.applyMask(mask)
+	}
+
+	q.applyMask(bestMask)
+	q.drawFormatBits(bestMask)
+
+	return q, nil
+}
+
+// Size returns the number of modules on each side of the symbol, without the quiet zone
+func (q *QRCode) Size() int {
+	return q.size
+}
+
+// Dark returns whether the module at column x and row y is dark
+func (q *QRCode) Dark(x, y int) bool {
+	if x < 0 || y < 0 || x >= q.size || y >= q.size {
+		return false
+	}
+	return q.modules[y][x]
+}
+
+// Image returns the symbol with its quiet zone, with scale pixels per module
+func (q *QRCode) Image(scale int) image.Image {
+	width := (q.size + 2*QuietZone) * scale
+	img := image.NewPaletted(image.Rect(0, 0, width, width), color.Palette{color.White, color.Black})
+
+	for y := 0; y < q.size; y++ {
+		for x := 0; x < q.size; x++ {
+			if !q.modules[y][x] {
+				continue
+			}
+
+			for py := 0; py < scale; py++ {
+				for px := 0; px < scale; px++ {
+					img.SetColorIndex((x+QuietZone)*scale+px, (y+QuietZone)*scale+py, 1)
+				}
+			}
+		}
+	}
+
+	return img
+}
+
+// PNG returns the symbol as a PNG image with scale pixels per module
+func (q *QRCode) PNG(scale int) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, q.Image(scale)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// SVG returns the symbol as an SVG image with scale pixels per module
+func (q *QRCode) SVG(scale int) []byte {
+	width := q.size + 2*QuietZone
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, width*scale, width*scale, width, width)
+	fmt.Fprintf(&buf, `<rect width="%d" height="%d" fill="#ffffff"/><path fill="#000000" d="`, width, width)
+
+	// each horizontal run of dark modules is drawn as a rectangle
+	for y := 0; y < q.size; y++ {
+		for x := 0; x < q.size; x++ {
+			if !q.modules[y][x] {
+				continue
+			}
+
+			run := 1
+			for x+run < q.size && q.modules[y][x+run] {
+				run++
+			}
+
+			fmt.Fprintf(&buf, "M%d %dh%dv1h-%dz", x+QuietZone, y+QuietZone, run, run)
+			x += run
+		}
+	}
+
+	buf.WriteString(`"/></svg>`)
+	return buf.Bytes()
+}
+
+func newGrid(size int) [][]bool {
+	grid := make([][]bool, size)
+	for i := range grid {
+		grid[i] = make([]bool, size)
+	}
+	return grid
+}
+
+// countBits returns the length of the byte mode character count indicator
+func countBits(version int) int {
+	if version < 10 {
+		return 8
+	}
+	return 16
+}
+
+// encodeData returns the data codewords of the symbol
+func encodeData(data []byte, version int) []byte {
+	capacity := layouts[version].dataCodewords()
+
+	var bits bitBuffer
+	bits.append(0x4, 4) // byte mode
+	bits.append(len(data), countBits(version))
+	for _, b := range data {
+		bits.append(int(b), 8)
+	}
+
+	// terminator, then padding to a byte boundary
+	terminator := capacity*8 - bits.len()
+	if terminator > 4 {
+		terminator = 4
+	}
+	bits.append(0, terminator)
+	bits.append(0, (8-bits.len()%8)%8)
+
+	codewords := bits.bytes()
+	for pad := byte(0xEC); len(codewords) < capacity; pad ^= 0xEC ^ 0x11 {
+		codewords = append(codewords, pad)
+	}
+
+	return codewords
+}
+
+// interleave splits the data codewords in blocks, adds their error correction codewords and interleaves them
+func interleave(layout blockLayout, data []byte) []byte {
+	blocks := make([][]byte, len(layout.blocks))
+	ecBlocks := make([][]byte, len(layout.blocks))
+	maxData := 0
+
+	for i, n := range layout.blocks {
+		blocks[i], data = data[:n], data[n:]
+		ecBlocks[i] = reedSolomon(blocks[i], layout.ecPerBlock)
+		if n > maxData {
+			maxData = n
+		}
+	}
+
+	var result []byte
+	for i := 0; i < maxData; i++ {
+		for _, b := range blocks {
+			if i < len(b) {
+				result = append(result, b[i])
+			}
+		}
+	}
+
+	for i := 0; i < layout.ecPerBlock; i++ {
+		for _, b := range ecBlocks {
+			result = append(result, b[i])
+		}
+	}
+
+	return result
+}
+
+func (q *QRCode) setFunction(x, y int, dark bool) {
+	q.modules[y][x] = dark
+	q.function[y][x] = true
+}
+
+func (q *QRCode) drawFunctionPatterns() {
+	for i := 0; i < q.size; i++ {
+		q.setFunction(6, i, i%2 == 0)
+		q.setFunction(i, 6, i%2 == 0)
+	}
+
+	q.drawFinderPattern(3, 3)
+	q.drawFinderPattern(q.size-4, 3)
+	q.drawFinderPattern(3, q.size-4)
+
+	positions := alignmentPositions[q.version]
+	last := len(positions) - 1
+	for i, y := range positions {
+		for j, x := range positions {
+			// the corners with finder patterns have no alignment pattern
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			q.drawAlignmentPattern(x, y)
+		}
+	}
+
+	// reserve the format bits, they are drawn once the mask is chosen
+	q.drawFormatBits(0)
+	q.drawVersionBits()
+}
+
+// drawFinderPattern draws a finder pattern and its separator centered on x, y
+func (q *QRCode) drawFinderPattern(x, y int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			px, py := x+dx, y+dy
+			if px < 0 || py < 0 || px >= q.size || py >= q.size {
+				continue
+			}
+
+			d := maxInt(absInt(dx), absInt(dy))
+			q.setFunction(px, py, d != 2 && d != 4)
+		}
+	}
+}
+
+// drawAlignmentPattern draws an alignment pattern centered on x, y
+func (q *QRCode) drawAlignmentPattern(x, y int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			q.setFunction(x+dx, y+dy, maxInt(absInt(dx), absInt(dy)) != 1)
+		}
+	}
+}
+
+// drawFormatBits draws both copies of the format information of error correction level M with mask
+func (q *QRCode) drawFormatBits(mask int) {
+	// error correction level M is 00
+	data := mask
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	bits := (data<<10 | rem) ^ 0x5412
+
+	for i := 0; i <= 5; i++ {
+		q.setFunction(8, i, bit(bits, i))
+	}
+	q.setFunction(8, 7, bit(bits, 6))
+	q.setFunction(8, 8, bit(bits, 7))
+	q.setFunction(7, 8, bit(bits, 8))
+	for i := 9; i < 15; i++ {
+		q.setFunction(14-i, 8, bit(bits, i))
+	}
+
+	for i := 0; i < 8; i++ {
+		q.setFunction(q.size-1-i, 8, bit(bits, i))
+	}
+	for i := 8; i < 15; i++ {
+		q.setFunction(8, q.size-15+i, bit(bits, i))
+	}
+
+	// dark module
+	q.setFunction(8, q.size-8, true)
+}
+
+// drawVersionBits draws both copies of the version information, which versions below 7 do not have
+func (q *QRCode) drawVersionBits() {
+	if q.version < 7 {
+		return
+	}
+
+	rem := q.version
+	for i := 0; i < 12; i++ {
+		rem = (rem << 1) ^ ((rem >> 11) * 0x1F25)
+	}
+	bits := q.version<<12 | rem
+
+	for i := 0; i < 18; i++ {
+		a := q.size - 11 + i%3
+		b := i / 3
+		q.setFunction(a, b, bit(bits, i))
+		q.setFunction(b, a, bit(bits, i))
+	}
+}
+
+// drawCodewords places the codewords in the data modules, in pairs of columns from the bottom right corner
+func (q *QRCode) drawCodewords(codewords []byte) {
+	i := 0
+	for right := q.size - 1; right >= 1; right -= 2 {
+		// skip the vertical timing pattern
+		if right == 6 {
+			right = 5
+		}
+
+		upward := (right+1)&2 == 0
+		for vert := 0; vert < q.size; vert++ {
+			y := vert
+			if upward {
+				y = q.size - 1 - vert
+			}
+
+			for j := 0; j < 2; j++ {
+				x := right - j
+				if q.function[y][x] || i >= len(codewords)*8 {
+					continue
+				}
+
+				q.modules[y][x] = bit(int(codewords[i/8]), 7-i%8)
+				i++
+			}
+		}
+	}
+}
+
+func (q *QRCode) applyMask(mask int) {
+	for y := 0; y < q.size; y++ {
+		for x := 0; x < q.size; x++ {
+			if q.function[y][x] {
+				continue
+			}
+
+			var invert bool
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+
+			if invert {
+				q.modules[y][x] = !q.modules[y][x]
+			}
+		}
+	}
+}
+
+// finderLike is the dark-light pattern of a finder pattern row, scored when four light modules are on either side of it
+var finderLike = []bool{true, false, true, true, true, false, true}
+
+// penalty scores how hard the symbol is to read, the mask with the lowest penalty is used.
+// The rules are scored as ZXing scores them, so both choose the same mask.
+func (q *QRCode) penalty() int {
+	penalty := 0
+
+	// rows and columns are scored the same way
+	line := func(get func(i int) bool) {
+		run := 1
+		for i := 1; i <= q.size; i++ {
+			if i < q.size && get(i) == get(i-1) {
+				run++
+				continue
+			}
+			if run >= 5 {
+				penalty += 3 + run - 5
+			}
+			run = 1
+		}
+
+		// modules outside of the symbol are in the quiet zone, which is light
+		light := func(from, to int) bool {
+			for i := maxInt(from, 0); i < to && i < q.size; i++ {
+				if get(i) {
+					return false
+				}
+			}
+			return true
+		}
+
+		for i := 0; i+len(finderLike) <= q.size; i++ {
+			match := true
+			for j, dark := range finderLike {
+				match = match && get(i+j) == dark
+			}
+			if match && (light(i-4, i) || light(i+len(finderLike), i+len(finderLike)+4)) {
+				penalty += 40
+			}
+		}
+	}
+
+	for y := 0; y < q.size; y++ {
+		line(func(i int) bool { return q.modules[y][i] })
+	}
+	for x := 0; x < q.size; x++ {
+		line(func(i int) bool { return q.modules[i][x] })
+	}
+
+	dark := 0
+	for y := 0; y < q.size; y++ {
+		for x := 0; x < q.size; x++ {
+			if q.modules[y][x] {
+				dark++
+			}
+
+			if x+1 < q.size && y+1 < q.size {
+				c := q.modules[y][x]
+				if q.modules[y][x+1] == c && q.modules[y+1][x] == c && q.modules[y+1][x+1] == c {
+					penalty += 3
+				}
+			}
+		}
+	}
+
+	// 10 points for each full 5% step away from half of the modules being dark
+	total := q.size * q.size
+	penalty += absInt(2*dark-total) * 10 / total * 10
+
+	return penalty
+}
+
+// bitBuffer is a sequence of bits
+type bitBuffer struct {
+	bits []bool
+}
+
+// append appends the n low bits of v, most significant first
+func (b *bitBuffer) append(v, n int) {
+	for i := n - 1; i >= 0; i-- {
+		b.bits = append(b.bits, bit(v, i))
+	}
+}
+
+func (b *bitBuffer) len() int {
+	return len(b.bits)
+}
+
+func (b *bitBuffer) bytes() []byte {
+	out := make([]byte, (len(b.bits)+7)/8)
+	for i, v := range b.bits {
+		if v {
+			out[i/8] |= 0x80 >> uint(i%8)
+		}
+	}
+	return out
+}
+
+func bit(v, i int) bool {
+	return (v>>uint(i))&1 != 0
+}
+
+func absInt(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,180 @@
+package qrcode
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReedSolomon(t *testing.T) {
+	// "HELLO WORLD" encoded as version 1-M
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	ec := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+
+	require.Equal(t, ec, reedSolomon(data, len(ec)))
+}
+
+func TestEncodeData(t *testing.T) {
+	codewords := encodeData([]byte("ab"), 1)
+	require.Len(t, codewords, 16)
+	// mode 0100, count 00000010, 'a' 01100001, 'b' 01100010, terminator 0000
+	require.Equal(t, []byte{0x40, 0x26, 0x16, 0x20, 0xEC, 0x11, 0xEC}, codewords[:7])
+}
+
+func TestEncode(t *testing.T) {
+	cases := []struct {
+		name   string
+		length int
+		size   int
+		err    error
+	}{
+		{
+			name: "empty",
+			err:  ErrEmptyData,
+		},
+		{
+			name:   "version 1",
+			length: 14,
+			size:   21,
+		},
+		{
+			name:   "version 2",
+			length: 15,
+			size:   25,
+		},
+		{
+			name:   "version 7",
+			length: 120,
+			size:   45,
+		},
+		{
+			name:   "version 10",
+			length: MaxDataLength,
+			size:   57,
+		},
+		{
+			name:   "too long",
+			length: MaxDataLength + 1,
+			err:    ErrDataTooLong,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			q, err := Encode([]byte(strings.Repeat("a", tc.length)))
+			if tc.err != nil {
+				require.Equal(t, tc.err, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.size, q.Size())
+
+			// finder pattern corners, a separator and the dark module
+			require.True(t, q.Dark(0, 0))
+			require.True(t, q.Dark(q.Size()-1, 0))
+			require.True(t, q.Dark(0, q.Size()-1))
+			require.False(t, q.Dark(7, 7))
+			require.True(t, q.Dark(8, q.Size()-8))
+
+			// timing patterns
+			for i := 8; i < q.Size()-8; i++ {
+				require.Equal(t, i%2 == 0, q.Dark(i, 6))
+				require.Equal(t, i%2 == 0, q.Dark(6, i))
+			}
+		})
+	}
+}
+
+// TestEncodeGolden compares symbols of each version with the largest data which fits them
+// to the symbols encoded by ZXing (github.com/makiuchi-d/gozxing) at error correction level M.
+// The golden files have the data on their first line, then a row of modules per line, # is dark.
+func TestEncodeGolden(t *testing.T) {
+	for version := 1; version <= maxVersion; version++ {
+		t.Run(fmt.Sprintf("version %d", version), func(t *testing.T) {
+			golden, err := ioutil.ReadFile(filepath.Join("testdata", fmt.Sprintf("version-%d.golden", version)))
+			require.NoError(t, err)
+
+			lines := strings.Split(strings.TrimSuffix(string(golden), "\n"), "\n")
+			data, rows := lines[0], lines[1:]
+
+			q, err := Encode([]byte(data))
+			require.NoError(t, err)
+			require.Equal(t, version, q.version)
+			require.Equal(t, len(rows), q.Size())
+
+			for y, row := range rows {
+				require.Len(t, row, q.Size())
+				for x := range row {
+					require.Equal(t, row[x] == '#', q.Dark(x, y), "module %d,%d", x, y)
+				}
+			}
+
+			// the data does not fit in the previous version
+			if version > 1 {
+				require.True(t, 4+countBits(version-1)+8*len(data) > layouts[version-1].dataCodewords()*8)
+			}
+		})
+	}
+}
+
+func TestPNG(t *testing.T) {
+	q, err := Encode([]byte("skycoin:2EU3JbveHdkxW6z5tdhbbB2kRAWvXC2pLzw"))
+	require.NoError(t, err)
+
+	data, err := q.PNG(2)
+	require.NoError(t, err)
+
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	width := (q.Size() + 2*QuietZone) * 2
+	require.Equal(t, width, img.Bounds().Dx())
+	require.Equal(t, width, img.Bounds().Dy())
+
+	for y := 0; y < width; y++ {
+		for x := 0; x < width; x++ {
+			r, _, _, _ := img.At(x, y).RGBA()
+			require.Equal(t, q.Dark(x/2-QuietZone, y/2-QuietZone), r == 0, "pixel %d,%d", x, y)
+		}
+	}
+}
+
+func TestSVG(t *testing.T) {
+	q, err := Encode([]byte("skycoin:2EU3JbveHdkxW6z5tdhbbB2kRAWvXC2pLzw?amount=1.5"))
+	require.NoError(t, err)
+
+	svg := string(q.SVG(4))
+	width := q.Size() + 2*QuietZone
+	require.Contains(t, svg, `width="`+strconv.Itoa(width*4)+`"`)
+
+	// redraw the modules from the path
+	dark := make(map[[2]int]bool)
+	for _, m := range regexp.MustCompile(`M(\d+) (\d+)h(\d+)v1h-(\d+)z`).FindAllStringSubmatch(svg, -1) {
+		x, err := strconv.Atoi(m[1])
+		require.NoError(t, err)
+		y, err := strconv.Atoi(m[2])
+		require.NoError(t, err)
+		run, err := strconv.Atoi(m[3])
+		require.NoError(t, err)
+		require.Equal(t, m[3], m[4])
+
+		for i := 0; i < run; i++ {
+			dark[[2]int{x + i - QuietZone, y - QuietZone}] = true
+		}
+	}
+
+	for y := 0; y < q.Size(); y++ {
+		for x := 0; x < q.Size(); x++ {
+			require.Equal(t, q.Dark(x, y), dark[[2]int{x, y}], "module %d,%d", x, y)
+		}
+	}
+}
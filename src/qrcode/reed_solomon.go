@@ -0,0 +1,60 @@
+package qrcode
+
+// GF(256) arithmetic with the QR code polynomial x^8 + x^4 + x^3 + x^2 + 1
+var (
+	gfExp [256]byte
+	gfLog [256]byte
+)
+
+func init() {
+	x := 1
+	for i := 0; i < 255; i++ {
+		gfExp[i] = byte(x)
+		gfLog[x] = byte(i)
+		x <<= 1
+		if x >= 0x100 {
+			x ^= 0x11D
+		}
+	}
+	gfExp[255] = gfExp[0]
+}
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[(int(gfLog[a])+int(gfLog[b]))%255]
+}
+
+// generatorPoly returns the coefficients of the Reed-Solomon generator polynomial
+// of the given degree, highest degree first. The leading coefficient is always 1.
+func generatorPoly(degree int) []byte {
+	poly := []byte{1}
+	for i := 0; i < degree; i++ {
+		// multiply by (x + a^i)
+		next := make([]byte, len(poly)+1)
+		for j, c := range poly {
+			next[j] ^= c
+			next[j+1] ^= gfMul(c, gfExp[i])
+		}
+		poly = next
+	}
+	return poly
+}
+
+// reedSolomon returns the n error correction codewords of data
+func reedSolomon(data []byte, n int) []byte {
+	gen := generatorPoly(n)
+	ec := make([]byte, n)
+
+	for _, d := range data {
+		factor := d ^ ec[0]
+		copy(ec, ec[1:])
+		ec[n-1] = 0
+		for i := 0; i < n; i++ {
+			ec[i] ^= gfMul(gen[i+1], factor)
+		}
+	}
+
+	return ec
+}